@@ -31,6 +31,7 @@ const (
 	QuerierRoute      = types.QuerierRoute
 	QueryParameters   = types.QueryParameters
 	RouterKey         = types.RouterKey
+	ConsensusVersion  = types.ConsensusVersion
 
 	AttributeValueCategory = types.AttributeValueCategory
 
@@ -26,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/btcx/exported"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common/telemetry"
 	polycommon "github.com/polynetwork/poly/common"
 	"github.com/tendermint/tendermint/libs/log"
 	"math/big"
@@ -68,10 +69,9 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, redeemScript string) error {
-	if reason, exist := k.ccmKeeper.ExistDenom(ctx, denom); exist {
-		return types.ErrCreateDenom(fmt.Sprintf("denom:%s already exist, due to reason:%s", denom, reason))
+	if err := k.ccmKeeper.RegisterDenom(ctx, creator, denom, types.ModuleName, 0, "", 0, nil); err != nil {
+		return types.ErrCreateDenom(fmt.Sprintf("RegisterDenom Error: %s", err.Error()))
 	}
-	k.ccmKeeper.SetDenomCreator(ctx, denom, creator)
 
 	redeemScriptBs, err := hex.DecodeString(redeemScript)
 	if err != nil {
@@ -116,6 +116,12 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAss
 }
 
 func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddr []byte, amount sdk.Int) error {
+	if err := k.ccmKeeper.ValidateToAddress(ctx, toChainId, toAddr); err != nil {
+		return types.ErrLock(fmt.Sprintf("ccmKeeper.ValidateToAddress Error: %s", err.Error()))
+	}
+	if err := k.ccmKeeper.ValidateToAmount(ctx, toChainId, amount); err != nil {
+		return types.ErrLock(fmt.Sprintf("ccmKeeper.ValidateToAmount Error: %s", err.Error()))
+	}
 	// transfer back to btc
 	store := ctx.KVStore(k.storeKey)
 	toAssetHash := store.Get(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
@@ -125,7 +131,8 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 	sink := polycommon.NewZeroCopySink(nil)
 	// construct args bytes
 	if toChainId == types.BtcChainId {
-		creator := k.ccmKeeper.GetDenomCreator(ctx, sourceAssetDenom)
+		// the redeem script is kept under the creator at creation, the denom ownership may have changed since
+		creator := sdk.AccAddress(store.Get(GetDenomToCreatorKey(sourceAssetDenom)))
 		if creator.Empty() {
 			return types.ErrLock(fmt.Sprintf("Creator of denom: %s is Empty", sourceAssetDenom))
 		}
@@ -167,6 +174,7 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	telemetry.RecordLock(ctx, types.ModuleName, sourceAssetDenom, amount)
 	return nil
 }
 
@@ -197,6 +205,7 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	telemetry.RecordUnlock(ctx, types.ModuleName, toDenom, amount)
 	return nil
 }
 
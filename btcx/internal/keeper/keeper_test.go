@@ -25,6 +25,7 @@ import (
 	"github.com/polynetwork/cosmos-poly-module/btcx"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/keeper"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
 	"github.com/polynetwork/cosmos-poly-module/simapp"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -181,6 +182,11 @@ func Test_btcx_MsgLock(t *testing.T) {
 	err = app.BtcxKeeper.BindAssetHash(ctx, creator, denom, 3, []byte{1, 2, 3, 5})
 	require.Nil(t, err)
 
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{
+		ccm.NewChainInfo(2, "chain2", ccm.VMFamilyEVM, 0, ccm.AddressEncodingRaw, 32, true),
+		ccm.NewChainInfo(3, "chain3", ccm.VMFamilyNeoVM, 0, ccm.AddressEncodingRaw, 32, true),
+	})
+
 	testCases := []struct {
 		from          sdk.AccAddress
 		denom         string
@@ -208,3 +214,20 @@ func Test_btcx_MsgLock(t *testing.T) {
 	require.Equal(t, "97btcx1", balance.String(), "balnace of creator is not balanced")
 
 }
+
+func Test_btcx_DenomOwnershipTransfer(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+	creator, newOwner := sdk.AccAddress([]byte("creator")), sdk.AccAddress([]byte("newOwner"))
+
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, "btcx1", "12345678"))
+	require.Nil(t, app.CcmKeeper.TransferDenomOwnership(ctx, creator, "btcx1", newOwner))
+	require.Nil(t, app.CcmKeeper.AcceptDenomOwnership(ctx, newOwner, "btcx1"))
+
+	require.Error(t, app.BtcxKeeper.BindAssetHash(ctx, creator, "btcx1", 2, []byte{1, 2, 3, 4}))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, newOwner, "btcx1", 2, []byte{1, 2, 3, 4}))
+
+	denomInfo := app.BtcxKeeper.GetDenomInfo(ctx, "btcx1")
+	require.Equal(t, newOwner.String(), denomInfo.Creator)
+	require.Equal(t, "12345678", denomInfo.RedeemScipt)
+}
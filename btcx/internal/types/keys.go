@@ -36,6 +36,9 @@ const (
 	// RouterKey is the message route for gov
 	RouterKey = ModuleName
 
+	// ConsensusVersion is the version of the store layout, the stores of older versions are migrated on upgrade
+	ConsensusVersion = 1
+
 	// Query endpoints supported by the minting querier
 	QueryParameters = "parameters"
 )
@@ -29,9 +29,20 @@ const (
 	QuerierRoute                                        = types.QuerierRoute
 	QueryParameters                                     = types.QueryParameters
 	RouterKey                                           = types.RouterKey
+	ConsensusVersion                                    = types.ConsensusVersion
+	MaxTxDetailsPrunedPerBlock                          = types.MaxTxDetailsPrunedPerBlock
+	MethodExecute                                       = types.MethodExecute
+	MethodStoreData                                     = types.MethodStoreData
+	MethodExecuteResult                                 = types.MethodExecuteResult
+	EventTypeExecuteRemoteMsgs                          = types.EventTypeExecuteRemoteMsgs
 	AttributeValueCategory                              = types.AttributeValueCategory
 	EventTypeCreateCrossChainTx                         = types.EventTypeCreateCrossChainTx
+	AttributeKeyStatus                                  = types.AttributeKeyStatus
+	AttributeKeyFromAddress                             = types.AttributeKeyFromAddress
+	AttributeKeyFromContract                            = types.AttributeKeyFromContract
+	AttributeKeyToChainId                               = types.AttributeKeyToChainId
 	AttributeCrossChainId                               = types.AttributeCrossChainId
+	AttributeKeySequence                                = types.AttributeKeySequence
 	AttributeKeyTxParamHash                             = types.AttributeKeyTxParamHash
 	AttributeKeyMakeTxParam                             = types.AttributeKeyMakeTxParam
 	EventTypeVerifyToCosmosProof                        = types.EventTypeVerifyToCosmosProof
@@ -39,29 +50,125 @@ const (
 	AttributeKeyMerkleValueMakeTxParamTxHash            = types.AttributeKeyMerkleValueMakeTxParamTxHash
 	AttributeKeyMerkleValueMakeTxParamToContractAddress = types.AttributeKeyMerkleValueMakeTxParamToContractAddress
 	AttributeKeyFromChainId                             = types.AttributeKeyFromChainId
+	VMFamilyEVM                                         = types.VMFamilyEVM
+	VMFamilyNeoVM                                       = types.VMFamilyNeoVM
+	VMFamilyWasmVM                                      = types.VMFamilyWasmVM
+	VMFamilyUTXO                                        = types.VMFamilyUTXO
+	VMFamilyCosmos                                      = types.VMFamilyCosmos
+	VMFamilyOther                                       = types.VMFamilyOther
+	AddressEncodingRaw                                  = types.AddressEncodingRaw
+	AddressEncodingBase58                               = types.AddressEncodingBase58
+	AddressEncodingBech32                               = types.AddressEncodingBech32
+	MaxAmountIntWidth                                   = types.MaxAmountIntWidth
+	EventTypeRegisterDenom                              = types.EventTypeRegisterDenom
+	EventTypeUpdateDenomMetadata                        = types.EventTypeUpdateDenomMetadata
+	AttributeKeyDenom                                   = types.AttributeKeyDenom
+	AttributeKeyCreator                                 = types.AttributeKeyCreator
+	AttributeKeyModule                                  = types.AttributeKeyModule
+	AttributeKeyDecimals                                = types.AttributeKeyDecimals
+	AttributeKeySymbol                                  = types.AttributeKeySymbol
+	AttributeKeyOriginChainId                           = types.AttributeKeyOriginChainId
+	AttributeKeyOriginAssetHash                         = types.AttributeKeyOriginAssetHash
+	EventTypeTransferDenomOwnership                     = types.EventTypeTransferDenomOwnership
+	EventTypeAcceptDenomOwnership                       = types.EventTypeAcceptDenomOwnership
+	AttributeKeyNewOwner                                = types.AttributeKeyNewOwner
+	AttributeKeyPreviousOwner                           = types.AttributeKeyPreviousOwner
 )
 
 var (
 	// functions aliases
-	RegisterCodec              = types.RegisterCodec
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	NewGenesisState            = types.NewGenesisState
-	DefaultGenesisState        = types.DefaultGenesisState
-	ValidateGenesis            = types.ValidateGenesis
-	NewMsgProcessCrossChainTx  = types.NewMsgProcessCrossChainTx
-	GetCrossChainTxKey         = keeper.GetCrossChainTxKey
-	GetDoneTxKey               = keeper.GetDoneTxKey
-	ModuleCdc                  = types.ModuleCdc
-	OperatorKey                = types.OperatorKey
-	NewQueryModuleBalanceParam = types.NewQueryModuleBalanceParam
-	QueryModuleBalance         = types.QueryModuleBalance
+	RegisterCodec                       = types.RegisterCodec
+	NewKeeper                           = keeper.NewKeeper
+	NewQuerier                          = keeper.NewQuerier
+	NewGenesisState                     = types.NewGenesisState
+	DefaultGenesisState                 = types.DefaultGenesisState
+	ValidateGenesis                     = types.ValidateGenesis
+	NewMsgProcessCrossChainTx           = types.NewMsgProcessCrossChainTx
+	NewMsgCreateCrossChainTx            = types.NewMsgCreateCrossChainTx
+	NewAllowedCrossChainCall            = types.NewAllowedCrossChainCall
+	GetSenderContractHash               = types.GetSenderContractHash
+	ErrCreateCrossChainTx               = types.ErrCreateCrossChainTx
+	GetCrossChainTxKey                  = keeper.GetCrossChainTxKey
+	GetDoneTxKey                        = keeper.GetDoneTxKey
+	GetDoneTxChainPrefix                = keeper.GetDoneTxChainPrefix
+	ModuleCdc                           = types.ModuleCdc
+	OperatorKey                         = types.OperatorKey
+	NewQueryModuleBalanceParam          = types.NewQueryModuleBalanceParam
+	QueryModuleBalance                  = types.QueryModuleBalance
+	QueryChainRegistry                  = types.QueryChainRegistry
+	QueryChainInfo                      = types.QueryChainInfo
+	NewQueryChainInfoParam              = types.NewQueryChainInfoParam
+	QueryCrossChainSequence             = types.QueryCrossChainSequence
+	QueryCrossChainTxBySequence         = types.QueryCrossChainTxBySequence
+	NewQueryCrossChainSequenceParam     = types.NewQueryCrossChainSequenceParam
+	NewQueryCrossChainTxBySequenceParam = types.NewQueryCrossChainTxBySequenceParam
+	GetCrossChainSequenceKey            = keeper.GetCrossChainSequenceKey
+	GetCrossChainSequenceTxKey          = keeper.GetCrossChainSequenceTxKey
+	QueryCrossChainTx                   = types.QueryCrossChainTx
+	QueryTxDetailRetainedHeight         = types.QueryTxDetailRetainedHeight
+	NewQueryCrossChainTxParam           = types.NewQueryCrossChainTxParam
+	NewChainInfo                        = types.NewChainInfo
+	ErrChainRegistry                    = types.ErrChainRegistry
+	NewMsgUpdateDenomMetadata           = types.NewMsgUpdateDenomMetadata
+	NewDenomInfo                        = types.NewDenomInfo
+	ErrDenomRegistry                    = types.ErrDenomRegistry
+	QueryDenomInfo                      = types.QueryDenomInfo
+	QueryDenomsByCreator                = types.QueryDenomsByCreator
+	QueryDenomsByModule                 = types.QueryDenomsByModule
+	NewQueryDenomInfoParam              = types.NewQueryDenomInfoParam
+	NewQueryDenomsByCreatorParam        = types.NewQueryDenomsByCreatorParam
+	NewQueryDenomsByModuleParam         = types.NewQueryDenomsByModuleParam
+	NewMsgTransferDenomOwnership        = types.NewMsgTransferDenomOwnership
+	NewMsgAcceptDenomOwnership          = types.NewMsgAcceptDenomOwnership
+	QueryDenomOwnership                 = types.QueryDenomOwnership
+	NewQueryDenomOwnershipParam         = types.NewQueryDenomOwnershipParam
+	QueryRelayFeeWaiver                 = types.QueryRelayFeeWaiver
+	NewQueryRelayFeeWaiverParam         = types.NewQueryRelayFeeWaiverParam
+	GetRelayFeeWaiverKey                = keeper.GetRelayFeeWaiverKey
+	DefaultRelayFeeWaiverWindow         = types.DefaultRelayFeeWaiverWindow
+	GetRemoteAccountAddress             = types.GetRemoteAccountAddress
+	RemoteAccountContractHash           = types.RemoteAccountContractHash
+	RemoteMsgType                       = types.RemoteMsgType
+	ErrRemoteAccount                    = types.ErrRemoteAccount
+	ErrRelayFeeRefund                   = types.ErrRelayFeeRefund
+	QueryRemoteAccount                  = types.QueryRemoteAccount
+	NewQueryRemoteAccountParam          = types.NewQueryRemoteAccountParam
+	DefaultParams                       = types.DefaultParams
+	KeyAllowedCrossChainCalls           = types.KeyAllowedCrossChainCalls
+	KeyChainRegistry                    = types.KeyChainRegistry
+	KeyTxDetailRetentionBlocks          = types.KeyTxDetailRetentionBlocks
+	KeyRelayFeeWaiverLimit              = types.KeyRelayFeeWaiverLimit
+	KeyRelayFeeWaiverWindow             = types.KeyRelayFeeWaiverWindow
+	KeyAllowedRemoteMsgTypes            = types.KeyAllowedRemoteMsgTypes
+	KeyDoneTxWindow                     = types.KeyDoneTxWindow
+	DefaultDoneTxWindow                 = types.DefaultDoneTxWindow
+	GetDoneTxIdKey                      = keeper.GetDoneTxIdKey
+	GetDoneTxIdChainPrefix              = keeper.GetDoneTxIdChainPrefix
+	GetDenomToCreatorKey                = keeper.GetDenomToCreatorKey
 )
 
 type (
-	Keeper                 = keeper.Keeper
-	MsgProcessCrossChainTx = types.MsgProcessCrossChainTx
-	UnlockKeeper           = types.UnlockKeeper
-	GenesisState           = types.GenesisState
-	Params                 = types.Params
+	Keeper                         = keeper.Keeper
+	MsgProcessCrossChainTx         = types.MsgProcessCrossChainTx
+	MsgCreateCrossChainTx          = types.MsgCreateCrossChainTx
+	AllowedCrossChainCall          = types.AllowedCrossChainCall
+	ChainInfo                      = types.ChainInfo
+	DenomInfo                      = types.DenomInfo
+	MsgUpdateDenomMetadata         = types.MsgUpdateDenomMetadata
+	MsgTransferDenomOwnership      = types.MsgTransferDenomOwnership
+	MsgAcceptDenomOwnership        = types.MsgAcceptDenomOwnership
+	DenomOwnershipTransfer         = types.DenomOwnershipTransfer
+	QueryDenomOwnershipRes         = types.QueryDenomOwnershipRes
+	UnlockKeeper                   = types.UnlockKeeper
+	DataKeeper                     = types.DataKeeper
+	GenesisState                   = types.GenesisState
+	Params                         = types.Params
+	QueryCrossChainSequenceRes     = types.QueryCrossChainSequenceRes
+	QueryCrossChainTxBySequenceRes = types.QueryCrossChainTxBySequenceRes
+	QueryCrossChainTxRes           = types.QueryCrossChainTxRes
+	QueryTxDetailRetainedHeightRes = types.QueryTxDetailRetainedHeightRes
+	QueryRelayFeeWaiverRes         = types.QueryRelayFeeWaiverRes
+	QueryRemoteAccountRes          = types.QueryRemoteAccountRes
+	ExecuteArgs                    = types.ExecuteArgs
+	ExecuteResultArgs              = types.ExecuteResultArgs
 )
@@ -0,0 +1,171 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ccm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/headersync"
+)
+
+// RejectDoneTxDecorator rejects in CheckTx and ReCheckTx the txs with a MsgProcessCrossChainTx whose cross chain
+// tx is already done or targets another chain, so that the duplicate submissions of competing relayers leave the
+// mempool instead of failing in DeliverTx. The proof is only decoded here, it is verified in DeliverTx as before
+type RejectDoneTxDecorator struct {
+	k keeper.Keeper
+}
+
+func NewRejectDoneTxDecorator(k keeper.Keeper) RejectDoneTxDecorator {
+	return RejectDoneTxDecorator{k: k}
+}
+
+func (d RejectDoneTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	for _, msg := range tx.GetMsgs() {
+		if msg, ok := msg.(types.MsgProcessCrossChainTx); ok {
+			if err := d.k.PrecheckCrossChainTx(ctx, msg.Proof); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+type relayFeeRefundKey struct{}
+
+// relayFeeRefund is shared by the msgs of one relay tx, the fee is refunded once all of them have succeeded, unless
+// one of them changed nothing
+type relayFeeRefund struct {
+	relayer sdk.AccAddress
+	fee     sdk.Coins
+	pending int
+	idle    bool
+}
+
+// RelayFeeWaiverDecorator marks for refund the fee of the txs made only of relay msgs, i.e. MsgSyncHeadersParam and
+// MsgProcessCrossChainTx, all signed by the fee payer, as long as none of the cross chain txs is already done or
+// targets another chain. The fee is charged as for any tx, the handlers wrapped by a RelayFeeRefundRouter pay it back
+// once all the msgs of the tx have succeeded and changed the consensus state, so a failing relay tx always pays, as
+// does a MsgSyncHeadersParam switching no consensus peers, e.g. of already synced headers. Every refunded tx counts
+// against the RelayFeeWaiverLimit of the fee payer for the current window
+type RelayFeeWaiverDecorator struct {
+	k keeper.Keeper
+}
+
+func NewRelayFeeWaiverDecorator(k keeper.Keeper) RelayFeeWaiverDecorator {
+	return RelayFeeWaiverDecorator{k: k}
+}
+
+func (d RelayFeeWaiverDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the msgs do not run in CheckTx
+	if ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok || feeTx.GetFee().IsZero() || !d.isRelayTx(ctx, feeTx) {
+		return next(ctx, tx, simulate)
+	}
+	refund := &relayFeeRefund{relayer: feeTx.FeePayer(), fee: feeTx.GetFee(), pending: len(feeTx.GetMsgs())}
+	return next(ctx.WithValue(relayFeeRefundKey{}, refund), tx, simulate)
+}
+
+func (d RelayFeeWaiverDecorator) isRelayTx(ctx sdk.Context, tx ante.FeeTx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	relayer := tx.FeePayer()
+	for _, msg := range msgs {
+		if !isRelayMsg(msg, relayer) {
+			return false
+		}
+		if msg, ok := msg.(types.MsgProcessCrossChainTx); ok && d.k.PrecheckCrossChainTx(ctx, msg.Proof) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func isRelayMsg(msg sdk.Msg, relayer sdk.AccAddress) bool {
+	switch msg := msg.(type) {
+	case types.MsgProcessCrossChainTx:
+		return msg.Submitter.Equals(relayer)
+	case headersync.MsgSyncHeadersParam:
+		return msg.Syncer.Equals(relayer)
+	default:
+		return false
+	}
+}
+
+// RelayFeeRefundRouter wraps the handlers added to router, so that the fee of a relay tx marked by the
+// RelayFeeWaiverDecorator is refunded by the handler of its last msg, provided all of them have succeeded and
+// changed the consensus state. A processed cross chain tx always does, a header sync only if it starts a new epoch
+type RelayFeeRefundRouter struct {
+	k      keeper.Keeper
+	router sdk.Router
+}
+
+func NewRelayFeeRefundRouter(k keeper.Keeper, router sdk.Router) RelayFeeRefundRouter {
+	return RelayFeeRefundRouter{k: k, router: router}
+}
+
+func (r RelayFeeRefundRouter) AddRoute(path string, h sdk.Handler) sdk.Router {
+	r.router.AddRoute(path, r.wrap(h))
+	return r
+}
+
+func (r RelayFeeRefundRouter) Route(ctx sdk.Context, path string) sdk.Handler {
+	return r.router.Route(ctx, path)
+}
+
+func (r RelayFeeRefundRouter) wrap(h sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := h(ctx, msg)
+		refund, ok := ctx.Value(relayFeeRefundKey{}).(*relayFeeRefund)
+		// the msgs run by the relay msgs, e.g. the msgs of remote accounts, are not relay msgs of the relayer
+		if err != nil || !ok || !isRelayMsg(msg, refund.relayer) {
+			return res, err
+		}
+		if _, ok := msg.(headersync.MsgSyncHeadersParam); ok && !updatesConsensusPeers(res) {
+			refund.idle = true
+		}
+		refund.pending--
+		if refund.pending == 0 && !refund.idle {
+			if _, err := r.k.RefundRelayFee(ctx, refund.relayer, refund.fee); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+}
+
+func updatesConsensusPeers(res *sdk.Result) bool {
+	if res == nil {
+		return false
+	}
+	for _, event := range res.Events {
+		if event.Type == headersync.EventTypeUpdateConsensusPeers {
+			return true
+		}
+	}
+	return false
+}
@@ -48,6 +48,18 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryIfContainContract(queryRoute, cdc),
 			GetCmdQueryCcmParams(queryRoute, cdc),
 			GetCmdQueryModuleBalance(queryRoute, cdc),
+			GetCmdQueryChainRegistry(queryRoute, cdc),
+			GetCmdQueryChainInfo(queryRoute, cdc),
+			GetCmdQueryCrossChainSequence(queryRoute, cdc),
+			GetCmdQueryCrossChainTxBySequence(queryRoute, cdc),
+			GetCmdQueryCrossChainTx(queryRoute, cdc),
+			GetCmdQueryTxDetailRetainedHeight(queryRoute, cdc),
+			GetCmdQueryDenomInfo(queryRoute, cdc),
+			GetCmdQueryDenomsByCreator(queryRoute, cdc),
+			GetCmdQueryDenomsByModule(queryRoute, cdc),
+			GetCmdQueryDenomOwnership(queryRoute, cdc),
+			GetCmdQueryRelayFeeWaiver(queryRoute, cdc),
+			GetCmdQueryRemoteAccount(queryRoute, cdc),
 		)...,
 	)
 
@@ -145,3 +157,351 @@ $ %s query %s module-balance lockproxy
 		},
 	}
 }
+
+func GetCmdQueryChainRegistry(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chain-registry",
+		Args:  cobra.NoArgs,
+		Short: "Query all the destination chains registered in the chain registry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s chain-registry
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryChainRegistry(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var chains []types.ChainInfo
+			cdc.MustUnmarshalJSON(res, &chains)
+			return cliCtx.PrintOutput(chains)
+		},
+	}
+}
+
+func GetCmdQueryChainInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chain-info [chain_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the registry info of destination chain with chain_id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s chain-info 2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryChainInfo(cliCtx, queryRoute, chainId)
+			if err != nil {
+				return err
+			}
+			var chain types.ChainInfo
+			cdc.MustUnmarshalJSON(res, &chain)
+			return cliCtx.PrintOutput(chain)
+		},
+	}
+}
+
+func GetCmdQueryCrossChainSequence(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cross-chain-sequence [to_chain_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the sequence the next cross chain tx towards to_chain_id will be assigned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s cross-chain-sequence 2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			toChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			resBs, err := common.QueryCrossChainSequence(cliCtx, queryRoute, toChainId)
+			if err != nil {
+				return err
+			}
+			var res types.QueryCrossChainSequenceRes
+			cdc.MustUnmarshalJSON(resBs, &res)
+			return cliCtx.PrintOutput(res)
+		},
+	}
+}
+
+func GetCmdQueryCrossChainTxBySequence(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cross-chain-tx-by-sequence [to_chain_id] [sequence]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the cross chain tx towards to_chain_id which has been assigned sequence",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s cross-chain-tx-by-sequence 2 0
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			toChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			resBs, err := common.QueryCrossChainTxBySequence(cliCtx, queryRoute, toChainId, sequence)
+			if err != nil {
+				return err
+			}
+			var res types.QueryCrossChainTxBySequenceRes
+			cdc.MustUnmarshalJSON(resBs, &res)
+			return cliCtx.PrintOutput(res)
+		},
+	}
+}
+
+func GetCmdQueryCrossChainTx(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cross-chain-tx [make_tx_param_hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the outgoing cross chain tx by the hex format of its make_tx_param_hash, the detail is empty once pruned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s cross-chain-tx 2d1dd5da8b2ee4e0c9ec7df9e09bab6296e9e2a6cc1d1f5ecf2fa7a4e5acd8aa
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txParamHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			resBs, err := common.QueryCrossChainTx(cliCtx, queryRoute, txParamHash)
+			if err != nil {
+				return err
+			}
+			var res types.QueryCrossChainTxRes
+			cdc.MustUnmarshalJSON(resBs, &res)
+			return cliCtx.PrintOutput(res)
+		},
+	}
+}
+
+func GetCmdQueryTxDetailRetainedHeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx-detail-retained-height",
+		Args:  cobra.NoArgs,
+		Short: "Query the oldest height from which the details of outgoing cross chain txs are retained",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s tx-detail-retained-height
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			resBs, err := common.QueryTxDetailRetainedHeight(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var res types.QueryTxDetailRetainedHeightRes
+			cdc.MustUnmarshalJSON(resBs, &res)
+			return cliCtx.PrintOutput(res)
+		},
+	}
+}
+
+func GetCmdQueryDenomInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-info [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the denom registry info of denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s denom-info btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryDenomInfo(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var info types.DenomInfo
+			cdc.MustUnmarshalJSON(res, &info)
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+func GetCmdQueryDenomsByCreator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denoms-by-creator [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the registered denoms created by creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s denoms-by-creator cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryDenomsByCreator(cliCtx, queryRoute, creator)
+			if err != nil {
+				return err
+			}
+			var infos []types.DenomInfo
+			cdc.MustUnmarshalJSON(res, &infos)
+			return cliCtx.PrintOutput(infos)
+		},
+	}
+}
+
+func GetCmdQueryDenomsByModule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denoms-by-module [module]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the registered denoms created by the bridge module, e.g. btcx, ft, lockproxy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s denoms-by-module lockproxy
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryDenomsByModule(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var infos []types.DenomInfo
+			cdc.MustUnmarshalJSON(res, &infos)
+			return cliCtx.PrintOutput(infos)
+		},
+	}
+}
+
+func GetCmdQueryDenomOwnership(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-ownership [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the owner, the pending owner and the ownership transfer history of denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s denom-ownership btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryDenomOwnership(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var ownership types.QueryDenomOwnershipRes
+			cdc.MustUnmarshalJSON(res, &ownership)
+			return cliCtx.PrintOutput(ownership)
+		},
+	}
+}
+
+func GetCmdQueryRelayFeeWaiver(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "relay-fee-waiver [relayer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query how many relay txs of relayer had their fee refunded in the current window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s relay-fee-waiver cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			relayer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryRelayFeeWaiver(cliCtx, queryRoute, relayer)
+			if err != nil {
+				return err
+			}
+			var waiver types.QueryRelayFeeWaiverRes
+			cdc.MustUnmarshalJSON(res, &waiver)
+			return cliCtx.PrintOutput(waiver)
+		},
+	}
+}
+
+func GetCmdQueryRemoteAccount(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remote-account [from_chain_id] [from_contract]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the account controlled by the contract from_contract of the chain from_chain_id and the msg types it may execute",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s remote-account 2 c330431496364497d7257839737b5e4596f5ac06
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			fromChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			fromContract, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryRemoteAccount(cliCtx, queryRoute, fromChainId, fromContract)
+			if err != nil {
+				return err
+			}
+			var account types.QueryRemoteAccountRes
+			cdc.MustUnmarshalJSON(res, &account)
+			return cliCtx.PrintOutput(account)
+		},
+	}
+}
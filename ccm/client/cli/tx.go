@@ -19,6 +19,7 @@ package cli
 
 import (
 	"bufio"
+	"encoding/hex"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
@@ -46,6 +47,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(flags.PostCommands(
 		SendProcessCrossChainTxTxCmd(cdc),
+		SendCreateCrossChainTxTxCmd(cdc),
+		SendUpdateDenomMetadataTxCmd(cdc),
+		SendTransferDenomOwnershipTxCmd(cdc),
+		SendAcceptDenomOwnershipTxCmd(cdc),
 	)...)
 	return txCmd
 }
@@ -83,3 +88,124 @@ $ %s tx %s process-crosschain-tx 0 'proof_hex_str_at_height_1000' 'header_1000'
 	}
 	return cmd
 }
+
+func SendCreateCrossChainTxTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-crosschain-tx [to_chain_id] [to_contract] [method] [args]",
+		Short: "create cross chain tx invoking method of an allowlisted contract on another chain, to_contract and args are hex encoded",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s create-crosschain-tx 2 c330431496364497d7257839737b5e4596f5ac06 'method' 'args_hex_str'
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			toChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toContract, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("decode hex string to_contract: %s, Error: %s", args[1], err.Error())
+			}
+			method := args[2]
+			txArgs, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("decode hex string args: %s, Error: %s", args[3], err.Error())
+			}
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCreateCrossChainTx(cliCtx.GetFromAddress(), toChainId, toContract, method, txArgs)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendUpdateDenomMetadataTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-metadata [denom] [decimals] [symbol]",
+		Short: "set the display decimals and symbol of denom in the denom registry, only the denom creator can do it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s update-denom-metadata btcx 8 BTC
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			decimals, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUpdateDenomMetadata(cliCtx.GetFromAddress(), args[0], uint32(decimals), args[2])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendTransferDenomOwnershipTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-denom-ownership [denom] [new_owner]",
+		Short: "propose new_owner as the creator of denom, new_owner has to accept it, proposing yourself cancels the pending transfer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s transfer-denom-ownership btcx cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgTransferDenomOwnership(cliCtx.GetFromAddress(), args[0], newOwner)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendAcceptDenomOwnershipTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-denom-ownership [denom]",
+		Short: "accept the pending ownership transfer of denom towards yourself",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s accept-denom-ownership btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgAcceptDenomOwnership(cliCtx.GetFromAddress(), args[0])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
@@ -20,6 +20,7 @@ package common
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
 )
 
@@ -49,3 +50,111 @@ func QueryModuleBalance(cliCtx context.CLIContext, queryRoute string, moduleName
 	)
 	return res, err
 }
+
+func QueryChainRegistry(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryChainRegistry),
+		nil,
+	)
+	return res, err
+}
+
+func QueryChainInfo(cliCtx context.CLIContext, queryRoute string, chainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryChainInfo),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryChainInfoParam(chainId)),
+	)
+	return res, err
+}
+
+func QueryCrossChainSequence(cliCtx context.CLIContext, queryRoute string, toChainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCrossChainSequence),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryCrossChainSequenceParam(toChainId)),
+	)
+	return res, err
+}
+
+func QueryCrossChainTxBySequence(cliCtx context.CLIContext, queryRoute string, toChainId, sequence uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCrossChainTxBySequence),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryCrossChainTxBySequenceParam(toChainId, sequence)),
+	)
+	return res, err
+}
+
+func QueryCrossChainTx(cliCtx context.CLIContext, queryRoute string, txParamHash []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCrossChainTx),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryCrossChainTxParam(txParamHash)),
+	)
+	return res, err
+}
+
+func QueryTxDetailRetainedHeight(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTxDetailRetainedHeight),
+		nil,
+	)
+	return res, err
+}
+
+func QueryDenomInfo(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomInfo),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomInfoParam(denom)),
+	)
+	return res, err
+}
+
+func QueryDenomsByCreator(cliCtx context.CLIContext, queryRoute string, creator sdk.AccAddress) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomsByCreator),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomsByCreatorParam(creator)),
+	)
+	return res, err
+}
+
+func QueryDenomsByModule(cliCtx context.CLIContext, queryRoute string, module string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomsByModule),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomsByModuleParam(module)),
+	)
+	return res, err
+}
+
+func QueryDenomOwnership(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomOwnership),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomOwnershipParam(denom)),
+	)
+	return res, err
+}
+
+func QueryRelayFeeWaiver(cliCtx context.CLIContext, queryRoute string, relayer sdk.AccAddress) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRelayFeeWaiver),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryRelayFeeWaiverParam(relayer)),
+	)
+	return res, err
+}
+
+func QueryRemoteAccount(cliCtx context.CLIContext, queryRoute string, fromChainId uint64, fromContract []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRemoteAccount),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryRemoteAccountParam(fromChainId, fromContract)),
+	)
+	return res, err
+}
@@ -28,6 +28,15 @@ const (
 	ToContract     = "to_contract"
 	FromChainId    = "from_chain_id"
 	ModuleName     = "module_name"
+	ChainId        = "chain_id"
+	ToChainId      = "to_chain_id"
+	Sequence       = "sequence"
+	TxParamHash    = "make_tx_param_hash"
+	Denom          = "denom"
+	Creator        = "creator"
+	Module         = "module"
+	Relayer        = "relayer"
+	FromContract   = "from_contract"
 )
 
 // RegisterRoutes registers minting module REST handlers on the provided router.
@@ -18,6 +18,7 @@
 package rest
 
 import (
+	"encoding/hex"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
 	"net/http"
@@ -32,6 +33,10 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/ccm/process_crosschain_tx", ProcessCrossChainTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/create_crosschain_tx", CreateCrossChainTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/update_denom_metadata", UpdateDenomMetadataRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/transfer_denom_ownership", TransferDenomOwnershipRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/accept_denom_ownership", AcceptDenomOwnershipRequestHandlerFn(cliCtx)).Methods("POST")
 
 }
 
@@ -66,3 +71,128 @@ func ProcessCrossChainTxRequestHandlerFn(cliCtx context.CLIContext) http.Handler
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+type CreateCrossChainTxReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	ToChainId  uint64       `json:"to_chain_id" yaml:"to_chain_id"`
+	ToContract string       `json:"to_contract" yaml:"to_contract"`
+	Method     string       `json:"method" yaml:"method"`
+	Args       string       `json:"args" yaml:"args"`
+}
+
+func CreateCrossChainTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateCrossChainTxReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		toContract, err := hex.DecodeString(req.ToContract)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		args, err := hex.DecodeString(req.Args)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgCreateCrossChainTx(fromAddr, req.ToChainId, toContract, req.Method, args)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type UpdateDenomMetadataReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom    string       `json:"denom" yaml:"denom"`
+	Decimals uint32       `json:"decimals" yaml:"decimals"`
+	Symbol   string       `json:"symbol" yaml:"symbol"`
+}
+
+func UpdateDenomMetadataRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDenomMetadataReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgUpdateDenomMetadata(fromAddr, req.Denom, req.Decimals, req.Symbol)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type TransferDenomOwnershipReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom    string       `json:"denom" yaml:"denom"`
+	NewOwner string       `json:"new_owner" yaml:"new_owner"`
+}
+
+func TransferDenomOwnershipRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TransferDenomOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgTransferDenomOwnership(fromAddr, req.Denom, newOwner)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type AcceptDenomOwnershipReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom   string       `json:"denom" yaml:"denom"`
+}
+
+func AcceptDenomOwnershipRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AcceptDenomOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgAcceptDenomOwnership(fromAddr, req.Denom)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,1122 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package events decodes the string events emitted by the ccm, lockproxy, lockproxypip1, btcx, ft and datarelay
+// modules, as found in the tx results, into typed structs, so that relayers and indexers need not parse the
+// attributes themselves.
+//
+// The sdk merges the events of the same type emitted within one message into a single sdk.StringEvent, so every
+// decoder returns all the events found in its sdk.StringEvent, in emission order. Several modules share the same
+// event types (e.g. "lock"), the typed structs cover the union of their attributes and leave absent ones empty.
+package events
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+)
+
+// event types, as emitted by the modules
+const (
+	EventTypeCreateCrossChainTx    = "make_from_cosmos_proof"
+	EventTypeVerifyToCosmosProof   = "verify_to_cosmos_proof"
+	EventTypeCreateLockProxy       = "create_lock_proxy"
+	EventTypeBindProxy             = "bind_proxy_hash"
+	EventTypeBindAsset             = "bind_asset_hash"
+	EventTypeLock                  = "lock"
+	EventTypeUnlock                = "unlock"
+	EventTypeRegisterAsset         = "register_asset"
+	EventTypeCreateAndDelegateCoin = "create_and_delegate_coin_to_proxy"
+
+	EventTypeRegisterDenom          = "register_denom"
+	EventTypeUpdateDenomMetadata    = "update_denom_metadata"
+	EventTypeTransferDenomOwnership = "transfer_denom_ownership"
+	EventTypeAcceptDenomOwnership   = "accept_denom_ownership"
+	EventTypeExecuteRemoteMsgs      = "execute_remote_msgs"
+
+	EventTypePendingBindProxy           = "pending_bind_proxy_hash"
+	EventTypePendingBindAsset           = "pending_bind_asset_hash"
+	EventTypeCancelPendingBindProxy     = "cancel_pending_bind_proxy_hash"
+	EventTypeCancelPendingBindAsset     = "cancel_pending_bind_asset_hash"
+	EventTypeUnbindProxy                = "unbind_proxy_hash"
+	EventTypeUnbindAsset                = "unbind_asset_hash"
+	EventTypeTransferLockProxyOwnership = "transfer_lock_proxy_ownership"
+	EventTypeAcceptLockProxyOwnership   = "accept_lock_proxy_ownership"
+	EventTypeUpdateLockLimits           = "update_lock_limits"
+
+	EventTypeStoreData = "store_data"
+	EventTypePublish   = "publish"
+)
+
+// attribute keys, as emitted by the modules
+const (
+	attrStatus                     = "status"
+	attrCrossChainId               = "cross_chainId"
+	attrTxParamHash                = "make_tx_param_hash"
+	attrMakeTxParam                = "make_tx_param"
+	attrSequence                   = "sequence"
+	attrMerkleValueTxHash          = "merkle_value:txhash"
+	attrMerkleValueMakeTxParamHash = "merkle_value:make_tx_param:txhash"
+	attrMerkleValueToContract      = "merkle_value:make_tx_param:to_contract_address"
+	attrCreator                    = "creator"
+	attrLockProxy                  = "lock_proxy_hash"
+	attrFromChainId                = "from_chain_id"
+	attrToChainId                  = "to_chain_id"
+	attrToChainProxyHash           = "to_chain_proxy_hash"
+	attrSourceAssetDenom           = "source_asset_denom"
+	attrSourceAssetHash            = "source_asset_hash"
+	attrFromAssetHash              = "from_asset_hash"
+	attrToAssetHash                = "to_asset_hash"
+	attrToChainAssetHash           = "to_chain_asset_hash"
+	attrFromAddress                = "from_address"
+	attrToAddress                  = "to_address"
+	attrFromContract               = "from_contract"
+	attrFromContractHash           = "from_contract_hash"
+	attrToContractHash             = "to_contract_hash"
+	attrAssetHash                  = "asset_hash"
+	attrNativeAssetHash            = "native_asset_hash"
+	attrAmount                     = "amount"
+	attrFeeAmount                  = "fee_amount"
+	attrFeeAddress                 = "fee_address"
+	attrNonce                      = "nonce"
+	attrSalt                       = "salt"
+	attrEffectiveHeight            = "effective_height"
+	attrSourceDecimals             = "source_decimals"
+	attrToDecimals                 = "to_decimals"
+	attrToAmount                   = "to_amount"
+	attrDust                       = "dust"
+	attrMinAmount                  = "min_amount"
+	attrMaxAmount                  = "max_amount"
+	attrOwner                      = "owner"
+	attrNewOwner                   = "new_owner"
+	attrPreviousOwner              = "previous_owner"
+	attrDenom                      = "denom"
+	attrModule                     = "module"
+	attrDecimals                   = "decimals"
+	attrSymbol                     = "symbol"
+	attrOriginChainId              = "origin_chain_id"
+	attrOriginAssetHash            = "origin_asset_hash"
+	attrRemoteAccount              = "remote_account"
+	attrTxHash                     = "tx_hash"
+	attrSuccess                    = "success"
+	attrError                      = "error"
+	attrTopic                      = "topic"
+	attrPolyHeight                 = "poly_height"
+	attrStored                     = "stored"
+	attrPublisher                  = "publisher"
+	attrPublisherHash              = "publisher_hash"
+	attrToContract                 = "to_contract"
+)
+
+// CreateCrossChainTx is emitted by ccm for every outgoing cross chain tx
+type CreateCrossChainTx struct {
+	Status       string
+	CrossChainId sdk.Int
+	TxParamHash  []byte
+	FromAddress  sdk.AccAddress
+	FromContract []byte
+	ToChainId    uint64
+	Sequence     uint64
+	MakeTxParam  *ccmc.MakeTxParam
+}
+
+// VerifyToCosmosProof is emitted by ccm for every inbound cross chain tx whose proof has been verified
+type VerifyToCosmosProof struct {
+	TxHash            []byte
+	MakeTxParamTxHash []byte
+	FromChainId       uint64
+	ToContractAddress []byte
+}
+
+// CreateLockProxy is emitted by lockproxy and lockproxypip1 on the creation of a lock proxy, Salt is set by lockproxy only
+type CreateLockProxy struct {
+	Creator       sdk.AccAddress
+	LockProxyHash []byte
+	Salt          string
+}
+
+// CreateAndDelegateCoin is emitted by lockproxy and lockproxypip1 when a coin is created and minted to the proxy
+type CreateAndDelegateCoin struct {
+	SourceAssetDenom string
+	Creator          sdk.AccAddress
+	Amount           sdk.Int
+}
+
+// BindProxy is emitted by lockproxy and lockproxypip1 when a proxy is bound to its counterpart on another chain
+type BindProxy struct {
+	LockProxyHash    []byte
+	ToChainId        uint64
+	ToChainProxyHash []byte
+}
+
+// BindAsset is emitted by lockproxy, lockproxypip1, btcx and ft when an asset is bound to its counterpart on another chain,
+// Creator is set by btcx and ft, LockProxyHash and the decimals by lockproxy
+type BindAsset struct {
+	Creator          sdk.AccAddress
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	FromAssetHash    []byte
+	ToChainId        uint64
+	ToAssetHash      []byte
+	SourceDecimals   uint32
+	ToDecimals       uint32
+}
+
+// Lock is emitted by lockproxy, lockproxypip1, btcx and ft for every asset leaving the chain,
+// LockProxyHash is set by lockproxy and lockproxypip1, ToAmount and Dust by lockproxy only,
+// ToChainProxyHash and the fee fields by lockproxypip1 only
+type Lock struct {
+	FromAssetHash    []byte
+	ToChainId        uint64
+	ToChainProxyHash []byte
+	ToAssetHash      []byte
+	FromAddress      sdk.AccAddress
+	ToAddress        []byte
+	Amount           sdk.Int
+	ToAmount         sdk.Int
+	Dust             sdk.Int
+	LockProxyHash    []byte
+	FeeAmount        sdk.Int
+	FeeAddress       sdk.AccAddress
+	Nonce            sdk.Int
+}
+
+// SourceAssetDenom returns the denom of the locked coin
+func (l Lock) SourceAssetDenom() string {
+	return string(l.FromAssetHash)
+}
+
+// Unlock is emitted by lockproxy, lockproxypip1, btcx and ft for every asset entering the chain,
+// FromAddress, SourceAssetHash and the fee fields are set by lockproxypip1 only
+type Unlock struct {
+	ToAssetHash     []byte
+	ToAddress       sdk.AccAddress
+	Amount          sdk.Int
+	FromAddress     sdk.AccAddress
+	SourceAssetHash []byte
+	FeeAmount       sdk.Int
+	FeeAddress      sdk.AccAddress
+	Nonce           sdk.Int
+}
+
+// ToAssetDenom returns the denom of the unlocked coin
+func (u Unlock) ToAssetDenom() string {
+	return string(u.ToAssetHash)
+}
+
+// RegisterAsset is emitted by lockproxypip1 when an asset is registered from another chain
+type RegisterAsset struct {
+	FromChainId      uint64
+	FromContractHash []byte
+	ToContractHash   []byte
+	AssetHash        []byte
+	NativeAssetHash  []byte
+}
+
+// PendingBindProxy is emitted by lockproxy when a proxy binding waits for the bind delay until EffectiveHeight
+type PendingBindProxy struct {
+	LockProxyHash    []byte
+	ToChainId        uint64
+	ToChainProxyHash []byte
+	EffectiveHeight  int64
+}
+
+// PendingBindAsset is emitted by lockproxy when an asset binding waits for the bind delay until EffectiveHeight
+type PendingBindAsset struct {
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ToChainId        uint64
+	ToAssetHash      []byte
+	SourceDecimals   uint32
+	ToDecimals       uint32
+	EffectiveHeight  int64
+}
+
+// Unbind is emitted by lockproxy when a binding, or a binding waiting for the bind delay, is removed,
+// SourceAssetDenom is empty for the proxy bindings
+type Unbind struct {
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ToChainId        uint64
+}
+
+// UpdateLockLimits is emitted by lockproxy when the operator of a lock proxy sets the lock limits of a binding
+type UpdateLockLimits struct {
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ToChainId        uint64
+	MinAmount        sdk.Int
+	MaxAmount        sdk.Int
+}
+
+// OwnershipTransfer is emitted by lockproxy for the lock proxies and by ccm for the denoms, both when the owner
+// proposes a new owner and when the new owner accepts it. Owner is the owner before the transfer,
+// LockProxyHash is set by lockproxy and Denom by ccm
+type OwnershipTransfer struct {
+	LockProxyHash []byte
+	Denom         string
+	Owner         sdk.AccAddress
+	NewOwner      sdk.AccAddress
+}
+
+// RegisterDenom is emitted by ccm when a denom is added to the denom registry
+type RegisterDenom struct {
+	Denom           string
+	Creator         sdk.AccAddress
+	Module          string
+	OriginChainId   uint64
+	OriginAssetHash []byte
+}
+
+// UpdateDenomMetadata is emitted by ccm when the creator of a denom updates its metadata
+type UpdateDenomMetadata struct {
+	Denom    string
+	Decimals uint32
+	Symbol   string
+}
+
+// ExecuteRemoteMsgs is emitted by ccm when the remote account of a contract of another chain executes msgs,
+// Error is only set when they failed
+type ExecuteRemoteMsgs struct {
+	FromChainId   uint64
+	FromContract  []byte
+	RemoteAccount sdk.AccAddress
+	TxHash        []byte
+	Success       bool
+	Error         string
+}
+
+// StoreData is emitted by datarelay for every data relayed from another chain, Stored is false when the data is
+// older than the data already stored under its topic
+type StoreData struct {
+	FromChainId  uint64
+	FromContract []byte
+	Topic        string
+	PolyHeight   uint32
+	Stored       bool
+}
+
+// Publish is emitted by datarelay for every data published to another chain
+type Publish struct {
+	Publisher     sdk.AccAddress
+	PublisherHash []byte
+	ToChainId     uint64
+	ToContract    []byte
+	Topic         string
+}
+
+// Events gathers all the bridge events found in the events of a tx result
+type Events struct {
+	CreateCrossChainTxs    []CreateCrossChainTx
+	VerifyToCosmosProofs   []VerifyToCosmosProof
+	CreateLockProxies      []CreateLockProxy
+	CreateAndDelegateCoins []CreateAndDelegateCoin
+	BindProxies            []BindProxy
+	BindAssets             []BindAsset
+	Locks                  []Lock
+	Unlocks                []Unlock
+	RegisterAssets         []RegisterAsset
+
+	PendingBindProxies          []PendingBindProxy
+	PendingBindAssets           []PendingBindAsset
+	CancelPendingBindProxies    []Unbind
+	CancelPendingBindAssets     []Unbind
+	UnbindProxies               []Unbind
+	UnbindAssets                []Unbind
+	UpdateLockLimits            []UpdateLockLimits
+	TransferLockProxyOwnerships []OwnershipTransfer
+	AcceptLockProxyOwnerships   []OwnershipTransfer
+
+	RegisterDenoms          []RegisterDenom
+	UpdateDenomMetadatas    []UpdateDenomMetadata
+	TransferDenomOwnerships []OwnershipTransfer
+	AcceptDenomOwnerships   []OwnershipTransfer
+	ExecuteRemoteMsgs       []ExecuteRemoteMsgs
+
+	StoreDatas []StoreData
+	Publishes  []Publish
+}
+
+// Decode decodes all the bridge events of events, the events of other types are ignored
+func Decode(events sdk.StringEvents) (Events, error) {
+	var res Events
+	for _, event := range events {
+		var err error
+		switch event.Type {
+		case EventTypeCreateCrossChainTx:
+			var decoded []CreateCrossChainTx
+			decoded, err = DecodeCreateCrossChainTx(event)
+			res.CreateCrossChainTxs = append(res.CreateCrossChainTxs, decoded...)
+		case EventTypeVerifyToCosmosProof:
+			var decoded []VerifyToCosmosProof
+			decoded, err = DecodeVerifyToCosmosProof(event)
+			res.VerifyToCosmosProofs = append(res.VerifyToCosmosProofs, decoded...)
+		case EventTypeCreateLockProxy:
+			var decoded []CreateLockProxy
+			decoded, err = DecodeCreateLockProxy(event)
+			res.CreateLockProxies = append(res.CreateLockProxies, decoded...)
+		case EventTypeCreateAndDelegateCoin:
+			var decoded []CreateAndDelegateCoin
+			decoded, err = DecodeCreateAndDelegateCoin(event)
+			res.CreateAndDelegateCoins = append(res.CreateAndDelegateCoins, decoded...)
+		case EventTypeBindProxy:
+			var decoded []BindProxy
+			decoded, err = DecodeBindProxy(event)
+			res.BindProxies = append(res.BindProxies, decoded...)
+		case EventTypeBindAsset:
+			var decoded []BindAsset
+			decoded, err = DecodeBindAsset(event)
+			res.BindAssets = append(res.BindAssets, decoded...)
+		case EventTypeLock:
+			var decoded []Lock
+			decoded, err = DecodeLock(event)
+			res.Locks = append(res.Locks, decoded...)
+		case EventTypeUnlock:
+			var decoded []Unlock
+			decoded, err = DecodeUnlock(event)
+			res.Unlocks = append(res.Unlocks, decoded...)
+		case EventTypeRegisterAsset:
+			var decoded []RegisterAsset
+			decoded, err = DecodeRegisterAsset(event)
+			res.RegisterAssets = append(res.RegisterAssets, decoded...)
+		case EventTypePendingBindProxy:
+			var decoded []PendingBindProxy
+			decoded, err = DecodePendingBindProxy(event)
+			res.PendingBindProxies = append(res.PendingBindProxies, decoded...)
+		case EventTypePendingBindAsset:
+			var decoded []PendingBindAsset
+			decoded, err = DecodePendingBindAsset(event)
+			res.PendingBindAssets = append(res.PendingBindAssets, decoded...)
+		case EventTypeCancelPendingBindProxy:
+			var decoded []Unbind
+			decoded, err = DecodeCancelPendingBindProxy(event)
+			res.CancelPendingBindProxies = append(res.CancelPendingBindProxies, decoded...)
+		case EventTypeCancelPendingBindAsset:
+			var decoded []Unbind
+			decoded, err = DecodeCancelPendingBindAsset(event)
+			res.CancelPendingBindAssets = append(res.CancelPendingBindAssets, decoded...)
+		case EventTypeUnbindProxy:
+			var decoded []Unbind
+			decoded, err = DecodeUnbindProxy(event)
+			res.UnbindProxies = append(res.UnbindProxies, decoded...)
+		case EventTypeUnbindAsset:
+			var decoded []Unbind
+			decoded, err = DecodeUnbindAsset(event)
+			res.UnbindAssets = append(res.UnbindAssets, decoded...)
+		case EventTypeUpdateLockLimits:
+			var decoded []UpdateLockLimits
+			decoded, err = DecodeUpdateLockLimits(event)
+			res.UpdateLockLimits = append(res.UpdateLockLimits, decoded...)
+		case EventTypeTransferLockProxyOwnership:
+			var decoded []OwnershipTransfer
+			decoded, err = DecodeTransferLockProxyOwnership(event)
+			res.TransferLockProxyOwnerships = append(res.TransferLockProxyOwnerships, decoded...)
+		case EventTypeAcceptLockProxyOwnership:
+			var decoded []OwnershipTransfer
+			decoded, err = DecodeAcceptLockProxyOwnership(event)
+			res.AcceptLockProxyOwnerships = append(res.AcceptLockProxyOwnerships, decoded...)
+		case EventTypeRegisterDenom:
+			var decoded []RegisterDenom
+			decoded, err = DecodeRegisterDenom(event)
+			res.RegisterDenoms = append(res.RegisterDenoms, decoded...)
+		case EventTypeUpdateDenomMetadata:
+			var decoded []UpdateDenomMetadata
+			decoded, err = DecodeUpdateDenomMetadata(event)
+			res.UpdateDenomMetadatas = append(res.UpdateDenomMetadatas, decoded...)
+		case EventTypeTransferDenomOwnership:
+			var decoded []OwnershipTransfer
+			decoded, err = DecodeTransferDenomOwnership(event)
+			res.TransferDenomOwnerships = append(res.TransferDenomOwnerships, decoded...)
+		case EventTypeAcceptDenomOwnership:
+			var decoded []OwnershipTransfer
+			decoded, err = DecodeAcceptDenomOwnership(event)
+			res.AcceptDenomOwnerships = append(res.AcceptDenomOwnerships, decoded...)
+		case EventTypeExecuteRemoteMsgs:
+			var decoded []ExecuteRemoteMsgs
+			decoded, err = DecodeExecuteRemoteMsgs(event)
+			res.ExecuteRemoteMsgs = append(res.ExecuteRemoteMsgs, decoded...)
+		case EventTypeStoreData:
+			var decoded []StoreData
+			decoded, err = DecodeStoreData(event)
+			res.StoreDatas = append(res.StoreDatas, decoded...)
+		case EventTypePublish:
+			var decoded []Publish
+			decoded, err = DecodePublish(event)
+			res.Publishes = append(res.Publishes, decoded...)
+		}
+		if err != nil {
+			return Events{}, err
+		}
+	}
+	return res, nil
+}
+
+func DecodeCreateCrossChainTx(event sdk.StringEvent) ([]CreateCrossChainTx, error) {
+	var res []CreateCrossChainTx
+	err := decodeEach(event, EventTypeCreateCrossChainTx, func(attrs attributes) error {
+		var e CreateCrossChainTx
+		e.Status = attrs[attrStatus]
+		if err := attrs.int(attrCrossChainId, &e.CrossChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrTxParamHash, &e.TxParamHash); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(attrFromAddress, &e.FromAddress); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrFromContract, &e.FromContract); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrSequence, &e.Sequence); err != nil {
+			return err
+		}
+		var makeTxParamBs []byte
+		if err := attrs.hex(attrMakeTxParam, &makeTxParamBs); err != nil {
+			return err
+		}
+		if makeTxParamBs != nil {
+			e.MakeTxParam = new(ccmc.MakeTxParam)
+			if err := e.MakeTxParam.Deserialization(polycommon.NewZeroCopySource(makeTxParamBs)); err != nil {
+				return fmt.Errorf("attribute: %s, MakeTxParam Deserialization Error: %s", attrMakeTxParam, err.Error())
+			}
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeVerifyToCosmosProof(event sdk.StringEvent) ([]VerifyToCosmosProof, error) {
+	var res []VerifyToCosmosProof
+	err := decodeEach(event, EventTypeVerifyToCosmosProof, func(attrs attributes) error {
+		var e VerifyToCosmosProof
+		if err := attrs.hex(attrMerkleValueTxHash, &e.TxHash); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrMerkleValueMakeTxParamHash, &e.MakeTxParamTxHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrFromChainId, &e.FromChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrMerkleValueToContract, &e.ToContractAddress); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeCreateLockProxy(event sdk.StringEvent) ([]CreateLockProxy, error) {
+	var res []CreateLockProxy
+	err := decodeEach(event, EventTypeCreateLockProxy, func(attrs attributes) error {
+		var e CreateLockProxy
+		if err := attrs.accAddress(attrCreator, &e.Creator); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		e.Salt = attrs[attrSalt]
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeCreateAndDelegateCoin(event sdk.StringEvent) ([]CreateAndDelegateCoin, error) {
+	var res []CreateAndDelegateCoin
+	err := decodeEach(event, EventTypeCreateAndDelegateCoin, func(attrs attributes) error {
+		var e CreateAndDelegateCoin
+		e.SourceAssetDenom = attrs[attrSourceAssetDenom]
+		if err := attrs.accAddress(attrCreator, &e.Creator); err != nil {
+			return err
+		}
+		if err := attrs.int(attrAmount, &e.Amount); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeBindProxy(event sdk.StringEvent) ([]BindProxy, error) {
+	var res []BindProxy
+	err := decodeEach(event, EventTypeBindProxy, func(attrs attributes) error {
+		var e BindProxy
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToChainProxyHash, &e.ToChainProxyHash); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeBindAsset(event sdk.StringEvent) ([]BindAsset, error) {
+	var res []BindAsset
+	err := decodeEach(event, EventTypeBindAsset, func(attrs attributes) error {
+		var e BindAsset
+		e.SourceAssetDenom = attrs[attrSourceAssetDenom]
+		if err := attrs.accAddress(attrCreator, &e.Creator); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrFromAssetHash, &e.FromAssetHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToAssetHash, &e.ToAssetHash); err != nil {
+			return err
+		}
+		if e.ToAssetHash == nil {
+			if err := attrs.hex(attrToChainAssetHash, &e.ToAssetHash); err != nil {
+				return err
+			}
+		}
+		if err := attrs.uint32(attrSourceDecimals, &e.SourceDecimals); err != nil {
+			return err
+		}
+		if err := attrs.uint32(attrToDecimals, &e.ToDecimals); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeLock(event sdk.StringEvent) ([]Lock, error) {
+	var res []Lock
+	err := decodeEach(event, EventTypeLock, func(attrs attributes) error {
+		var e Lock
+		if err := attrs.hex(attrFromAssetHash, &e.FromAssetHash); err != nil {
+			return err
+		}
+		// lockproxypip1 reports the source asset as the from contract
+		if e.FromAssetHash == nil {
+			if err := attrs.hex(attrFromContractHash, &e.FromAssetHash); err != nil {
+				return err
+			}
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToChainProxyHash, &e.ToChainProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToAssetHash, &e.ToAssetHash); err != nil {
+			return err
+		}
+		if e.ToAssetHash == nil {
+			if err := attrs.hex(attrToChainAssetHash, &e.ToAssetHash); err != nil {
+				return err
+			}
+		}
+		if err := attrs.accAddress(attrFromAddress, &e.FromAddress); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToAddress, &e.ToAddress); err != nil {
+			return err
+		}
+		if err := attrs.int(attrAmount, &e.Amount); err != nil {
+			return err
+		}
+		if err := attrs.int(attrToAmount, &e.ToAmount); err != nil {
+			return err
+		}
+		if err := attrs.int(attrDust, &e.Dust); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.int(attrFeeAmount, &e.FeeAmount); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(attrFeeAddress, &e.FeeAddress); err != nil {
+			return err
+		}
+		if err := attrs.int(attrNonce, &e.Nonce); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeUnlock(event sdk.StringEvent) ([]Unlock, error) {
+	var res []Unlock
+	err := decodeEach(event, EventTypeUnlock, func(attrs attributes) error {
+		var e Unlock
+		if err := attrs.hex(attrToAssetHash, &e.ToAssetHash); err != nil {
+			return err
+		}
+		if e.ToAssetHash == nil {
+			if err := attrs.hex(attrToChainAssetHash, &e.ToAssetHash); err != nil {
+				return err
+			}
+		}
+		if err := attrs.accAddress(attrToAddress, &e.ToAddress); err != nil {
+			return err
+		}
+		if err := attrs.int(attrAmount, &e.Amount); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(attrFromAddress, &e.FromAddress); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrSourceAssetHash, &e.SourceAssetHash); err != nil {
+			return err
+		}
+		if err := attrs.int(attrFeeAmount, &e.FeeAmount); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(attrFeeAddress, &e.FeeAddress); err != nil {
+			return err
+		}
+		if err := attrs.int(attrNonce, &e.Nonce); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeRegisterAsset(event sdk.StringEvent) ([]RegisterAsset, error) {
+	var res []RegisterAsset
+	err := decodeEach(event, EventTypeRegisterAsset, func(attrs attributes) error {
+		var e RegisterAsset
+		if err := attrs.uint64(attrFromChainId, &e.FromChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrFromContractHash, &e.FromContractHash); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrAssetHash, &e.AssetHash); err != nil {
+			return err
+		}
+		// lockproxypip1 emits the raw bytes of these two
+		if v, ok := attrs[attrToContractHash]; ok {
+			e.ToContractHash = []byte(v)
+		}
+		if v, ok := attrs[attrNativeAssetHash]; ok {
+			e.NativeAssetHash = []byte(v)
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodePendingBindProxy(event sdk.StringEvent) ([]PendingBindProxy, error) {
+	var res []PendingBindProxy
+	err := decodeEach(event, EventTypePendingBindProxy, func(attrs attributes) error {
+		var e PendingBindProxy
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToChainProxyHash, &e.ToChainProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.int64(attrEffectiveHeight, &e.EffectiveHeight); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodePendingBindAsset(event sdk.StringEvent) ([]PendingBindAsset, error) {
+	var res []PendingBindAsset
+	err := decodeEach(event, EventTypePendingBindAsset, func(attrs attributes) error {
+		var e PendingBindAsset
+		e.SourceAssetDenom = attrs[attrSourceAssetDenom]
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToAssetHash, &e.ToAssetHash); err != nil {
+			return err
+		}
+		if err := attrs.uint32(attrSourceDecimals, &e.SourceDecimals); err != nil {
+			return err
+		}
+		if err := attrs.uint32(attrToDecimals, &e.ToDecimals); err != nil {
+			return err
+		}
+		if err := attrs.int64(attrEffectiveHeight, &e.EffectiveHeight); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeCancelPendingBindProxy(event sdk.StringEvent) ([]Unbind, error) {
+	return decodeUnbind(event, EventTypeCancelPendingBindProxy)
+}
+
+func DecodeCancelPendingBindAsset(event sdk.StringEvent) ([]Unbind, error) {
+	return decodeUnbind(event, EventTypeCancelPendingBindAsset)
+}
+
+func DecodeUnbindProxy(event sdk.StringEvent) ([]Unbind, error) {
+	return decodeUnbind(event, EventTypeUnbindProxy)
+}
+
+func DecodeUnbindAsset(event sdk.StringEvent) ([]Unbind, error) {
+	return decodeUnbind(event, EventTypeUnbindAsset)
+}
+
+func decodeUnbind(event sdk.StringEvent, eventType string) ([]Unbind, error) {
+	var res []Unbind
+	err := decodeEach(event, eventType, func(attrs attributes) error {
+		var e Unbind
+		e.SourceAssetDenom = attrs[attrSourceAssetDenom]
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeUpdateLockLimits(event sdk.StringEvent) ([]UpdateLockLimits, error) {
+	var res []UpdateLockLimits
+	err := decodeEach(event, EventTypeUpdateLockLimits, func(attrs attributes) error {
+		var e UpdateLockLimits
+		e.SourceAssetDenom = attrs[attrSourceAssetDenom]
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.int(attrMinAmount, &e.MinAmount); err != nil {
+			return err
+		}
+		if err := attrs.int(attrMaxAmount, &e.MaxAmount); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeTransferLockProxyOwnership(event sdk.StringEvent) ([]OwnershipTransfer, error) {
+	return decodeOwnershipTransfer(event, EventTypeTransferLockProxyOwnership, attrOwner)
+}
+
+func DecodeAcceptLockProxyOwnership(event sdk.StringEvent) ([]OwnershipTransfer, error) {
+	return decodeOwnershipTransfer(event, EventTypeAcceptLockProxyOwnership, attrPreviousOwner)
+}
+
+func DecodeTransferDenomOwnership(event sdk.StringEvent) ([]OwnershipTransfer, error) {
+	return decodeOwnershipTransfer(event, EventTypeTransferDenomOwnership, attrCreator)
+}
+
+func DecodeAcceptDenomOwnership(event sdk.StringEvent) ([]OwnershipTransfer, error) {
+	return decodeOwnershipTransfer(event, EventTypeAcceptDenomOwnership, attrPreviousOwner)
+}
+
+// decodeOwnershipTransfer decodes the ownership events, which report the owner before the transfer under ownerKey
+func decodeOwnershipTransfer(event sdk.StringEvent, eventType string, ownerKey string) ([]OwnershipTransfer, error) {
+	var res []OwnershipTransfer
+	err := decodeEach(event, eventType, func(attrs attributes) error {
+		var e OwnershipTransfer
+		e.Denom = attrs[attrDenom]
+		if err := attrs.hex(attrLockProxy, &e.LockProxyHash); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(ownerKey, &e.Owner); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(attrNewOwner, &e.NewOwner); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeRegisterDenom(event sdk.StringEvent) ([]RegisterDenom, error) {
+	var res []RegisterDenom
+	err := decodeEach(event, EventTypeRegisterDenom, func(attrs attributes) error {
+		var e RegisterDenom
+		e.Denom = attrs[attrDenom]
+		e.Module = attrs[attrModule]
+		if err := attrs.accAddress(attrCreator, &e.Creator); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrOriginChainId, &e.OriginChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrOriginAssetHash, &e.OriginAssetHash); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeUpdateDenomMetadata(event sdk.StringEvent) ([]UpdateDenomMetadata, error) {
+	var res []UpdateDenomMetadata
+	err := decodeEach(event, EventTypeUpdateDenomMetadata, func(attrs attributes) error {
+		var e UpdateDenomMetadata
+		e.Denom = attrs[attrDenom]
+		e.Symbol = attrs[attrSymbol]
+		if err := attrs.uint32(attrDecimals, &e.Decimals); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeExecuteRemoteMsgs(event sdk.StringEvent) ([]ExecuteRemoteMsgs, error) {
+	var res []ExecuteRemoteMsgs
+	err := decodeEach(event, EventTypeExecuteRemoteMsgs, func(attrs attributes) error {
+		var e ExecuteRemoteMsgs
+		e.Error = attrs[attrError]
+		if err := attrs.uint64(attrFromChainId, &e.FromChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrFromContract, &e.FromContract); err != nil {
+			return err
+		}
+		if err := attrs.accAddress(attrRemoteAccount, &e.RemoteAccount); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrTxHash, &e.TxHash); err != nil {
+			return err
+		}
+		if err := attrs.bool(attrSuccess, &e.Success); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodeStoreData(event sdk.StringEvent) ([]StoreData, error) {
+	var res []StoreData
+	err := decodeEach(event, EventTypeStoreData, func(attrs attributes) error {
+		var e StoreData
+		e.Topic = attrs[attrTopic]
+		if err := attrs.uint64(attrFromChainId, &e.FromChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrFromContract, &e.FromContract); err != nil {
+			return err
+		}
+		if err := attrs.uint32(attrPolyHeight, &e.PolyHeight); err != nil {
+			return err
+		}
+		if err := attrs.bool(attrStored, &e.Stored); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+func DecodePublish(event sdk.StringEvent) ([]Publish, error) {
+	var res []Publish
+	err := decodeEach(event, EventTypePublish, func(attrs attributes) error {
+		var e Publish
+		e.Topic = attrs[attrTopic]
+		if err := attrs.accAddress(attrPublisher, &e.Publisher); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrPublisherHash, &e.PublisherHash); err != nil {
+			return err
+		}
+		if err := attrs.uint64(attrToChainId, &e.ToChainId); err != nil {
+			return err
+		}
+		if err := attrs.hex(attrToContract, &e.ToContract); err != nil {
+			return err
+		}
+		res = append(res, e)
+		return nil
+	})
+	return res, err
+}
+
+// attributes holds the attributes of one single emitted event
+type attributes map[string]string
+
+// decodeEach splits the merged event into the attributes of every emitted event, a new event starts whenever
+// an attribute key shows up again, since no event carries the same key twice
+func decodeEach(event sdk.StringEvent, eventType string, decode func(attributes) error) error {
+	if event.Type != eventType {
+		return fmt.Errorf("expect event type: %s, got: %s", eventType, event.Type)
+	}
+	var attrs attributes
+	for _, attr := range event.Attributes {
+		if _, ok := attrs[attr.Key]; ok || attrs == nil {
+			if attrs != nil {
+				if err := decode(attrs); err != nil {
+					return fmt.Errorf("decode event: %s Error: %s", eventType, err.Error())
+				}
+			}
+			attrs = make(attributes)
+		}
+		attrs[attr.Key] = attr.Value
+	}
+	if attrs != nil {
+		if err := decode(attrs); err != nil {
+			return fmt.Errorf("decode event: %s Error: %s", eventType, err.Error())
+		}
+	}
+	return nil
+}
+
+func (attrs attributes) hex(key string, dst *[]byte) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	bs, err := hex.DecodeString(v)
+	if err != nil {
+		return fmt.Errorf("attribute: %s is not hex: %s", key, v)
+	}
+	if bs == nil {
+		bs = []byte{}
+	}
+	*dst = bs
+	return nil
+}
+
+func (attrs attributes) uint64(key string, dst *uint64) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return fmt.Errorf("attribute: %s is not an uint64: %s", key, v)
+	}
+	*dst = n
+	return nil
+}
+
+func (attrs attributes) uint32(key string, dst *uint32) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return fmt.Errorf("attribute: %s is not an uint32: %s", key, v)
+	}
+	*dst = uint32(n)
+	return nil
+}
+
+func (attrs attributes) int64(key string, dst *int64) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return fmt.Errorf("attribute: %s is not an int64: %s", key, v)
+	}
+	*dst = n
+	return nil
+}
+
+func (attrs attributes) bool(key string, dst *bool) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("attribute: %s is not a bool: %s", key, v)
+	}
+	*dst = b
+	return nil
+}
+
+func (attrs attributes) int(key string, dst *sdk.Int) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	n, ok := sdk.NewIntFromString(v)
+	if !ok {
+		return fmt.Errorf("attribute: %s is not an integer: %s", key, v)
+	}
+	*dst = n
+	return nil
+}
+
+func (attrs attributes) accAddress(key string, dst *sdk.AccAddress) error {
+	v, ok := attrs[key]
+	if !ok {
+		return nil
+	}
+	// the address format is not verified, it is reported as emitted
+	addr, err := sdk.GetFromBech32(v, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return fmt.Errorf("attribute: %s is not a bech32 address: %s", key, v)
+	}
+	*dst = sdk.AccAddress(addr)
+	return nil
+}
@@ -0,0 +1,339 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package events_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/btcx"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
+	"github.com/polynetwork/cosmos-poly-module/ccm/events"
+	"github.com/polynetwork/cosmos-poly-module/datarelay"
+	"github.com/polynetwork/cosmos-poly-module/ft"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	params := ccm.DefaultGenesisState().Params
+	params.ChainIdInPolyNet = 5
+	params.ChainRegistry = []ccm.ChainInfo{ccm.NewChainInfo(2, "ethereum", ccm.VMFamilyEVM, 20, ccm.AddressEncodingRaw, 32, true)}
+	app.CcmKeeper.SetParams(ctx, params)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+	return app, ctx
+}
+
+func decodeEmitted(t *testing.T, ctx sdk.Context) events.Events {
+	decoded, err := events.Decode(sdk.StringifyEvents(ctx.EventManager().ABCIEvents()))
+	require.Nil(t, err)
+	return decoded
+}
+
+func Test_events_LockProxy(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("operator"))
+	denom := "coin1"
+	toProxyHash := bytes.Repeat([]byte{0xaa}, 20)
+	toAssetHash := bytes.Repeat([]byte{0xbb}, 20)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.CreateLockProxy(ctx, operator, ""))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin(denom, sdk.NewInt(1000)), proxy))
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, toProxyHash))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, denom, 2, toAssetHash, lockproxy.AssetDecimals{}))
+	decoded := decodeEmitted(t, ctx)
+	require.Equal(t, []events.CreateLockProxy{{Creator: operator, LockProxyHash: proxy}}, decoded.CreateLockProxies)
+	require.Equal(t, []events.CreateAndDelegateCoin{{SourceAssetDenom: denom, Creator: operator, Amount: sdk.NewInt(1000)}}, decoded.CreateAndDelegateCoins)
+	require.Equal(t, []events.BindProxy{{LockProxyHash: proxy, ToChainId: 2, ToChainProxyHash: toProxyHash}}, decoded.BindProxies)
+	require.Equal(t, []events.BindAsset{{LockProxyHash: proxy, SourceAssetDenom: denom, FromAssetHash: []byte(denom), ToChainId: 2, ToAssetHash: toAssetHash}}, decoded.BindAssets)
+
+	// two locks within the same message are flattened into one string event per type
+	user := sdk.AccAddress([]byte("user"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+	_, err := app.BankKeeper.AddCoins(ctx, user, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	require.Nil(t, err)
+	toAddresses := [][]byte{bytes.Repeat([]byte{1}, 20), bytes.Repeat([]byte{2}, 20)}
+	amounts := []sdk.Int{sdk.NewInt(10), sdk.NewInt(20)}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	for i := range toAddresses {
+		require.Nil(t, k.Lock(ctx, proxy, user, denom, 2, toAddresses[i], amounts[i]))
+	}
+	decoded = decodeEmitted(t, ctx)
+	require.Len(t, decoded.Locks, 2)
+	require.Len(t, decoded.CreateCrossChainTxs, 2)
+	for i, lock := range decoded.Locks {
+		require.Equal(t, events.Lock{
+			FromAssetHash: []byte(denom),
+			ToChainId:     2,
+			ToAssetHash:   toAssetHash,
+			FromAddress:   user,
+			ToAddress:     toAddresses[i],
+			Amount:        amounts[i],
+			ToAmount:      amounts[i],
+			Dust:          sdk.ZeroInt(),
+			LockProxyHash: proxy,
+		}, lock)
+		require.Equal(t, denom, lock.SourceAssetDenom())
+
+		tx := decoded.CreateCrossChainTxs[i]
+		require.Equal(t, "1", tx.Status)
+		require.Equal(t, sdk.NewInt(int64(i)), tx.CrossChainId)
+		require.Equal(t, uint64(i), tx.Sequence)
+		require.Equal(t, uint64(2), tx.ToChainId)
+		require.Equal(t, user, tx.FromAddress)
+		require.Equal(t, proxy, tx.FromContract)
+		require.Equal(t, toProxyHash, tx.MakeTxParam.ToContractAddress)
+		require.Equal(t, "unlock", tx.MakeTxParam.Method)
+		txParamHash, makeTxParam, found := app.CcmKeeper.GetCrossChainTxBySequence(ctx, 2, tx.Sequence)
+		require.True(t, found)
+		require.Equal(t, txParamHash, tx.TxParamHash)
+		sink := polycommon.NewZeroCopySink(nil)
+		tx.MakeTxParam.Serialization(sink)
+		require.Equal(t, makeTxParam, sink.Bytes())
+	}
+
+	args := lockproxy.TxArgs{ToAssetHash: []byte(denom), ToAddress: user, Amount: amounts[0].BigInt()}
+	sink := polycommon.NewZeroCopySink(nil)
+	require.Nil(t, args.Serialization(sink, 32))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.Unlock(ctx, 2, toProxyHash, proxy, sink.Bytes()))
+	decoded = decodeEmitted(t, ctx)
+	require.Equal(t, []events.Unlock{{ToAssetHash: []byte(denom), ToAddress: user, Amount: amounts[0]}}, decoded.Unlocks)
+	require.Equal(t, denom, decoded.Unlocks[0].ToAssetDenom())
+}
+
+func Test_events_LockProxyBindings(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.LockProxyKeeper
+	ctx = ctx.WithBlockHeight(5)
+
+	operator, newOwner := sdk.AccAddress([]byte("operator")), sdk.AccAddress([]byte("new owner"))
+	denom := "coin1"
+	toProxyHash := bytes.Repeat([]byte{0xaa}, 20)
+	toAssetHash := bytes.Repeat([]byte{0xbb}, 20)
+	decimals := lockproxy.NewAssetDecimals(6, 18)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.CreateLockProxy(ctx, operator, "salt"))
+	proxy := lockproxy.GetLockProxyHash(operator, "salt")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin(denom, sdk.NewInt(1000)), proxy))
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, toProxyHash))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, denom, 2, toAssetHash, decimals))
+	require.Nil(t, k.UpdateLockLimits(ctx, operator, proxy, denom, 2, lockproxy.NewLockLimits(sdk.NewInt(1), sdk.NewInt(100))))
+	decoded := decodeEmitted(t, ctx)
+	require.Equal(t, []events.CreateLockProxy{{Creator: operator, LockProxyHash: proxy, Salt: "salt"}}, decoded.CreateLockProxies)
+	require.Equal(t, []events.BindAsset{{LockProxyHash: proxy, SourceAssetDenom: denom, FromAssetHash: []byte(denom), ToChainId: 2, ToAssetHash: toAssetHash, SourceDecimals: 6, ToDecimals: 18}}, decoded.BindAssets)
+	require.Equal(t, []events.UpdateLockLimits{{LockProxyHash: proxy, SourceAssetDenom: denom, ToChainId: 2, MinAmount: sdk.NewInt(1), MaxAmount: sdk.NewInt(100)}}, decoded.UpdateLockLimits)
+
+	// with a bind delay the bindings wait, until they are cancelled
+	k.SetParams(ctx, lockproxy.NewParams(10))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 3, toProxyHash))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, denom, 3, toAssetHash, decimals))
+	require.Nil(t, k.CancelPendingProxyBinding(ctx, operator, proxy, 3))
+	require.Nil(t, k.CancelPendingAssetBinding(ctx, operator, proxy, denom, 3))
+	decoded = decodeEmitted(t, ctx)
+	require.Equal(t, []events.PendingBindProxy{{LockProxyHash: proxy, ToChainId: 3, ToChainProxyHash: toProxyHash, EffectiveHeight: 15}}, decoded.PendingBindProxies)
+	require.Equal(t, []events.PendingBindAsset{{LockProxyHash: proxy, SourceAssetDenom: denom, ToChainId: 3, ToAssetHash: toAssetHash, SourceDecimals: 6, ToDecimals: 18, EffectiveHeight: 15}}, decoded.PendingBindAssets)
+	require.Equal(t, []events.Unbind{{LockProxyHash: proxy, ToChainId: 3}}, decoded.CancelPendingBindProxies)
+	require.Equal(t, []events.Unbind{{LockProxyHash: proxy, SourceAssetDenom: denom, ToChainId: 3}}, decoded.CancelPendingBindAssets)
+	require.Empty(t, decoded.BindProxies)
+	require.Empty(t, decoded.BindAssets)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.UnbindProxyHash(ctx, operator, proxy, 2))
+	require.Nil(t, k.UnbindAssetHash(ctx, operator, proxy, denom, 2))
+	require.Nil(t, k.TransferLockProxyOwnership(ctx, operator, proxy, newOwner))
+	require.Nil(t, k.AcceptLockProxyOwnership(ctx, newOwner, proxy))
+	decoded = decodeEmitted(t, ctx)
+	require.Equal(t, []events.Unbind{{LockProxyHash: proxy, ToChainId: 2}}, decoded.UnbindProxies)
+	require.Equal(t, []events.Unbind{{LockProxyHash: proxy, SourceAssetDenom: denom, ToChainId: 2}}, decoded.UnbindAssets)
+	require.Equal(t, []events.OwnershipTransfer{{LockProxyHash: proxy, Owner: operator, NewOwner: newOwner}}, decoded.TransferLockProxyOwnerships)
+	require.Equal(t, []events.OwnershipTransfer{{LockProxyHash: proxy, Owner: operator, NewOwner: newOwner}}, decoded.AcceptLockProxyOwnerships)
+}
+
+func Test_events_Denoms(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+	alice, bob := sdk.AccAddress([]byte("alice")), sdk.AccAddress([]byte("bob"))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.RegisterDenom(ctx, alice, "btca", "btcx", 8, "BTC", 1, []byte{1}))
+	require.Nil(t, k.RegisterDenom(ctx, alice, "usdt", "lockproxy", 0, "", 0, nil))
+	require.Nil(t, k.UpdateDenomMetadata(ctx, alice, "btca", 6, "XBTC"))
+	require.Nil(t, k.TransferDenomOwnership(ctx, alice, "btca", bob))
+	require.Nil(t, k.AcceptDenomOwnership(ctx, bob, "btca"))
+	decoded := decodeEmitted(t, ctx)
+	require.Equal(t, []events.RegisterDenom{
+		{Denom: "btca", Creator: alice, Module: "btcx", OriginChainId: 1, OriginAssetHash: []byte{1}},
+		{Denom: "usdt", Creator: alice, Module: "lockproxy", OriginAssetHash: []byte{}},
+	}, decoded.RegisterDenoms)
+	require.Equal(t, []events.UpdateDenomMetadata{{Denom: "btca", Decimals: 6, Symbol: "XBTC"}}, decoded.UpdateDenomMetadatas)
+	require.Equal(t, []events.OwnershipTransfer{{Denom: "btca", Owner: alice, NewOwner: bob}}, decoded.TransferDenomOwnerships)
+	require.Equal(t, []events.OwnershipTransfer{{Denom: "btca", Owner: alice, NewOwner: bob}}, decoded.AcceptDenomOwnerships)
+}
+
+func Test_events_BtcxFt(t *testing.T) {
+	app, ctx := createTestApp(true)
+	creator, user := sdk.AccAddress([]byte("creator")), sdk.AccAddress([]byte("user"))
+	toAssetHash := bytes.Repeat([]byte{0xbb}, 20)
+	toAddress := bytes.Repeat([]byte{1}, 20)
+
+	// btcx
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, "btcx1", "5121"))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, "btcx1", 2, toAssetHash))
+	sink := polycommon.NewZeroCopySink(nil)
+	btcArgs := btcx.BTCArgs{ToBtcAddress: user, Amount: 10}
+	require.Nil(t, btcArgs.Serialization(sink))
+	require.Nil(t, app.BtcxKeeper.Unlock(ctx, 2, toAssetHash, []byte("btcx1"), sink.Bytes()))
+	require.Nil(t, app.BtcxKeeper.Lock(ctx, user, "btcx1", 2, toAddress, sdk.NewInt(4)))
+	decoded := decodeEmitted(t, ctx)
+	require.Equal(t, []events.BindAsset{{Creator: creator, SourceAssetDenom: "btcx1", FromAssetHash: []byte("btcx1"), ToChainId: 2, ToAssetHash: toAssetHash}}, decoded.BindAssets)
+	require.Equal(t, []events.Unlock{{ToAssetHash: []byte("btcx1"), ToAddress: user, Amount: sdk.NewInt(10)}}, decoded.Unlocks)
+	require.Equal(t, []events.Lock{{FromAssetHash: []byte("btcx1"), ToChainId: 2, ToAssetHash: toAssetHash, FromAddress: user, ToAddress: toAddress, Amount: sdk.NewInt(4)}}, decoded.Locks)
+	require.Len(t, decoded.CreateCrossChainTxs, 1)
+
+	// ft
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "ft1"))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, "ft1", 2, toAssetHash))
+	sink = polycommon.NewZeroCopySink(nil)
+	txArgs := ft.TxArgs{ToAddress: user, Amount: sdk.NewInt(10).BigInt()}
+	require.Nil(t, txArgs.Serialization(sink, 32))
+	require.Nil(t, app.FtKeeper.Unlock(ctx, 2, toAssetHash, []byte("ft1"), sink.Bytes()))
+	// ft reads the amount in the byte order of the other chains, the event reports the amount minted
+	unlocked := app.BankKeeper.GetCoins(ctx, user).AmountOf("ft1")
+	require.Nil(t, app.FtKeeper.Lock(ctx, user, "ft1", 2, toAddress, sdk.NewInt(4)))
+	decoded = decodeEmitted(t, ctx)
+	require.Equal(t, []events.BindAsset{{Creator: creator, SourceAssetDenom: "ft1", FromAssetHash: []byte("ft1"), ToChainId: 2, ToAssetHash: toAssetHash}}, decoded.BindAssets)
+	require.Equal(t, []events.Unlock{{ToAssetHash: []byte("ft1"), ToAddress: user, Amount: unlocked}}, decoded.Unlocks)
+	require.Equal(t, []events.Lock{{FromAssetHash: []byte("ft1"), ToChainId: 2, ToAssetHash: toAssetHash, FromAddress: user, ToAddress: toAddress, Amount: sdk.NewInt(4)}}, decoded.Locks)
+	require.Len(t, decoded.CreateCrossChainTxs, 1)
+}
+
+func Test_events_ExecuteRemoteMsgs(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.CcmKeeper
+	fromContract := bytes.Repeat([]byte{0xcc}, 20)
+	remote := ccm.GetRemoteAccountAddress(2, fromContract)
+	recipient := sdk.AccAddress([]byte("recipient-address-20"))
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)))
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, remote, coins))
+	params := k.GetParams(ctx)
+	params.AllowedRemoteMsgTypes = []string{"bank/send"}
+	k.SetParams(ctx, params)
+
+	execute := func(msgs ...sdk.Msg) {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := ccm.ExecuteArgs{Msgs: app.Codec().MustMarshalJSON(msgs)}
+		args.Serialization(sink)
+		require.Nil(t, k.ProcessExecuteTx(ctx, &ccmc.ToMerkleValue{
+			TxHash:      []byte("polyhash"),
+			FromChainID: 2,
+			MakeTxParam: &ccmc.MakeTxParam{
+				TxHash:              []byte("txhash"),
+				CrossChainID:        []byte{1},
+				FromContractAddress: fromContract,
+				ToChainID:           5,
+				ToContractAddress:   ccm.RemoteAccountContractHash,
+				Method:              ccm.MethodExecute,
+				Args:                sink.Bytes(),
+			},
+		}))
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	execute(bank.NewMsgSend(remote, recipient, coins))
+	execute(bank.NewMsgSend(remote, recipient, coins))
+	decoded := decodeEmitted(t, ctx)
+	require.Len(t, decoded.ExecuteRemoteMsgs, 2)
+	require.Equal(t, events.ExecuteRemoteMsgs{FromChainId: 2, FromContract: fromContract, RemoteAccount: remote, TxHash: []byte("txhash"), Success: true}, decoded.ExecuteRemoteMsgs[0])
+	failed := decoded.ExecuteRemoteMsgs[1]
+	require.False(t, failed.Success)
+	require.NotEmpty(t, failed.Error)
+	require.Equal(t, remote, failed.RemoteAccount)
+	require.Len(t, decoded.CreateCrossChainTxs, 2)
+}
+
+func Test_events_DataRelay(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.DataRelayKeeper
+	sender := bytes.Repeat([]byte{0xdd}, 20)
+	k.SetParams(ctx, datarelay.NewParams([]datarelay.Sender{datarelay.NewSender(2, sender)}))
+
+	storeData := func(data []byte, polyHeight uint32) {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := datarelay.StoreDataArgs{Topic: "ETH/USD", Data: data}
+		args.Serialization(sink)
+		require.Nil(t, k.StoreData(ctx, 2, sender, datarelay.ContractHash, sink.Bytes(), polyHeight))
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	storeData([]byte{1}, 10)
+	storeData([]byte{2}, 9)
+	decoded := decodeEmitted(t, ctx)
+	require.Equal(t, []events.StoreData{
+		{FromChainId: 2, FromContract: sender, Topic: "ETH/USD", PolyHeight: 10, Stored: true},
+		{FromChainId: 2, FromContract: sender, Topic: "ETH/USD", PolyHeight: 9, Stored: false},
+	}, decoded.StoreDatas)
+
+	publisher := sdk.AccAddress([]byte("publisher"))
+	toContract := bytes.Repeat([]byte{0xee}, 20)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.Publish(ctx, publisher, 2, toContract, "BTC/USD", []byte{3}))
+	decoded = decodeEmitted(t, ctx)
+	require.Equal(t, []events.Publish{{Publisher: publisher, PublisherHash: datarelay.GetPublisherContractHash(publisher), ToChainId: 2, ToContract: toContract, Topic: "BTC/USD"}}, decoded.Publishes)
+	require.Len(t, decoded.CreateCrossChainTxs, 1)
+}
+
+func Test_events_Malformed(t *testing.T) {
+	testCases := []sdk.StringEvent{
+		{Type: events.EventTypeLock, Attributes: []sdk.Attribute{{Key: "to_chain_id", Value: "two"}}},
+		{Type: events.EventTypeLock, Attributes: []sdk.Attribute{{Key: "to_address", Value: "0xzz"}}},
+		{Type: events.EventTypeUnlock, Attributes: []sdk.Attribute{{Key: "to_address", Value: "cosmos1invalid"}}},
+		{Type: events.EventTypeCreateCrossChainTx, Attributes: []sdk.Attribute{{Key: "make_tx_param", Value: "0102"}}},
+		{Type: events.EventTypeVerifyToCosmosProof, Attributes: []sdk.Attribute{{Key: "from_chain_id", Value: "-1"}}},
+		{Type: events.EventTypePendingBindProxy, Attributes: []sdk.Attribute{{Key: "effective_height", Value: "soon"}}},
+		{Type: events.EventTypeUpdateDenomMetadata, Attributes: []sdk.Attribute{{Key: "decimals", Value: "4294967296"}}},
+		{Type: events.EventTypeExecuteRemoteMsgs, Attributes: []sdk.Attribute{{Key: "success", Value: "maybe"}}},
+		{Type: events.EventTypeAcceptDenomOwnership, Attributes: []sdk.Attribute{{Key: "new_owner", Value: "cosmos1invalid"}}},
+	}
+	for _, testCase := range testCases {
+		_, err := events.Decode(sdk.StringEvents{testCase})
+		require.Error(t, err, "event: %+v", testCase)
+	}
+
+	_, err := events.DecodeLock(sdk.StringEvent{Type: events.EventTypeUnlock})
+	require.Error(t, err)
+
+	decoded, err := events.Decode(sdk.StringEvents{{Type: "transfer", Attributes: []sdk.Attribute{{Key: "amount", Value: "1stake"}}}})
+	require.Nil(t, err)
+	require.Equal(t, events.Events{}, decoded)
+}
@@ -24,7 +24,9 @@ import (
 // DelegationI delegation bond for a delegated proof of stake system
 type CCMKeeper interface {
 	CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toChainId uint64, fromContractHash, toContractHash []byte, method string, args []byte) error
-	SetDenomCreator(ctx sdk.Context, denom string, creator sdk.AccAddress)
+	RegisterDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, module string, decimals uint32, symbol string, originChainId uint64, originAssetHash []byte) error
 	GetDenomCreator(ctx sdk.Context, denom string) sdk.AccAddress
 	ExistDenom(ctx sdk.Context, denom string) (string, bool)
+	ValidateToAddress(ctx sdk.Context, toChainId uint64, toAddr []byte) error
+	ValidateToAmount(ctx sdk.Context, toChainId uint64, amount sdk.Int) error
 }
@@ -31,6 +31,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgProcessCrossChainTx:
 			return handleMsgProcessCrossChainTx(ctx, k, msg)
+		case types.MsgCreateCrossChainTx:
+			return handleMsgCreateCrossChainTx(ctx, k, msg)
+		case types.MsgUpdateDenomMetadata:
+			return handleMsgUpdateDenomMetadata(ctx, k, msg)
+		case types.MsgTransferDenomOwnership:
+			return handleMsgTransferDenomOwnership(ctx, k, msg)
+		case types.MsgAcceptDenomOwnership:
+			return handleMsgAcceptDenomOwnership(ctx, k, msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -54,3 +62,71 @@ func handleMsgProcessCrossChainTx(ctx sdk.Context, k keeper.Keeper, msg types.Ms
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgCreateCrossChainTx(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateCrossChainTx) (*sdk.Result, error) {
+
+	err := k.CreateCrossChainTxFromSender(ctx, msg.Sender, msg.ToChainID, msg.ToContractAddress, msg.Method, msg.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUpdateDenomMetadata(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateDenomMetadata) (*sdk.Result, error) {
+
+	err := k.UpdateDenomMetadata(ctx, msg.Creator, msg.Denom, msg.Decimals, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferDenomOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferDenomOwnership) (*sdk.Result, error) {
+
+	err := k.TransferDenomOwnership(ctx, msg.Owner, msg.Denom, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAcceptDenomOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgAcceptDenomOwnership) (*sdk.Result, error) {
+
+	err := k.AcceptDenomOwnership(ctx, msg.NewOwner, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,267 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+)
+
+// RegisterDenom records a new denom created by module in the denom registry, it fails if the denom already
+// exists, either registered or minted by any other means. An empty symbol defaults to the denom, originChainId 0
+// with an empty originAssetHash stands for an asset native to this chain
+func (k Keeper) RegisterDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, module string, decimals uint32, symbol string, originChainId uint64, originAssetHash []byte) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return types.ErrDenomRegistry(err.Error())
+	}
+	if reason, exist := k.ExistDenom(ctx, denom); exist {
+		return types.ErrDenomRegistry(fmt.Sprintf("denom: %s already exist, due to reason: %s", denom, reason))
+	}
+	if symbol == "" {
+		symbol = denom
+	}
+	info := types.NewDenomInfo(denom, creator, module, decimals, symbol, originChainId, originAssetHash, ctx.BlockHeight())
+	if err := info.Validate(); err != nil {
+		return types.ErrDenomRegistry(err.Error())
+	}
+	k.setDenomInfo(ctx, info)
+	k.setDenomCreator(ctx, denom, creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyModule, module),
+			sdk.NewAttribute(types.AttributeKeyOriginChainId, strconv.FormatUint(originChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginAssetHash, fmt.Sprintf("%x", originAssetHash)),
+		),
+	)
+	return nil
+}
+
+// GetDenomInfo returns the registry entry of denom, the denoms created before the registry only carry their creator
+func (k Keeper) GetDenomInfo(ctx sdk.Context, denom string) (types.DenomInfo, bool) {
+	var info types.DenomInfo
+	bz := ctx.KVStore(k.storeKey).Get(GetDenomInfoKey(denom))
+	if bz == nil {
+		creator := k.GetDenomCreator(ctx, denom)
+		if len(creator) == 0 {
+			return info, false
+		}
+		return types.DenomInfo{Denom: denom, Creator: creator, Symbol: denom}, true
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// setDenomInfo stores info and keeps the creator and module indexes in line with it
+func (k Keeper) setDenomInfo(ctx sdk.Context, info types.DenomInfo) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(GetDenomInfoKey(info.Denom)); bz != nil {
+		var old types.DenomInfo
+		k.cdc.MustUnmarshalBinaryBare(bz, &old)
+		store.Delete(GetDenomByCreatorKey(old.Creator, old.Denom))
+		store.Delete(GetDenomByModuleKey(old.Module, old.Denom))
+	}
+	store.Set(GetDenomInfoKey(info.Denom), k.cdc.MustMarshalBinaryBare(info))
+	store.Set(GetDenomByCreatorKey(info.Creator, info.Denom), []byte{})
+	store.Set(GetDenomByModuleKey(info.Module, info.Denom), []byte{})
+}
+
+// GetDenomsByCreator returns the registered denoms created by creator, sorted by denom
+func (k Keeper) GetDenomsByCreator(ctx sdk.Context, creator sdk.AccAddress) []types.DenomInfo {
+	return k.getIndexedDenoms(ctx, GetDenomByCreatorPrefix(creator))
+}
+
+// GetDenomsByModule returns the registered denoms created by module, sorted by denom
+func (k Keeper) GetDenomsByModule(ctx sdk.Context, module string) []types.DenomInfo {
+	return k.getIndexedDenoms(ctx, GetDenomByModulePrefix(module))
+}
+
+func (k Keeper) getIndexedDenoms(ctx sdk.Context, prefix []byte) []types.DenomInfo {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	infos := make([]types.DenomInfo, 0)
+	for ; iter.Valid(); iter.Next() {
+		if info, ok := k.GetDenomInfo(ctx, string(iter.Key()[len(prefix):])); ok {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// MigrateDenomIndex indexes the denoms created before the registry by their creator and, when the bridge module
+// owning them can be inferred, records them under that module. The module is inferred from the bridge modules
+// mounted as unlock keepers, the one which has bound the denom towards a registered chain owns it
+func (k Keeper) MigrateDenomIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var denoms []string
+	iter := sdk.KVStorePrefixIterator(store, DenomToCreatorPrefix)
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()[len(DenomToCreatorPrefix):]))
+	}
+	iter.Close()
+
+	for _, denom := range denoms {
+		if bz := store.Get(GetDenomInfoKey(denom)); bz != nil {
+			var info types.DenomInfo
+			k.cdc.MustUnmarshalBinaryBare(bz, &info)
+			k.setDenomInfo(ctx, info)
+			continue
+		}
+		creator := k.GetDenomCreator(ctx, denom)
+		if module := k.inferDenomModule(ctx, denom); module != "" {
+			k.setDenomInfo(ctx, types.NewDenomInfo(denom, creator, module, 0, denom, 0, nil, 0))
+			continue
+		}
+		store.Set(GetDenomByCreatorKey(creator, denom), []byte{})
+	}
+}
+
+// inferDenomModule returns the name of the unlock keeper which has bound denom towards a registered chain, empty
+// if there is none
+func (k Keeper) inferDenomModule(ctx sdk.Context, denom string) string {
+	modules := make([]string, 0, len(k.ulKeeperMap))
+	for module := range k.ulKeeperMap {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	chains := k.GetChainRegistry(ctx)
+	for _, module := range modules {
+		for _, chain := range chains {
+			if k.ulKeeperMap[module].ContainToContractAddr(ctx, []byte(denom), chain.ChainId) {
+				return module
+			}
+		}
+	}
+	return ""
+}
+
+// UpdateDenomMetadata sets the display decimals and symbol of the registered denom, on behalf of its creator
+func (k Keeper) UpdateDenomMetadata(ctx sdk.Context, creator sdk.AccAddress, denom string, decimals uint32, symbol string) error {
+	bz := ctx.KVStore(k.storeKey).Get(GetDenomInfoKey(denom))
+	if bz == nil {
+		return types.ErrDenomRegistry(fmt.Sprintf("denom: %s is not registered", denom))
+	}
+	if !bytes.Equal(k.GetDenomCreator(ctx, denom), creator) {
+		return types.ErrDenomRegistry(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.GetDenomCreator(ctx, denom).String(), creator.String()))
+	}
+	var info types.DenomInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	info.Decimals = decimals
+	info.Symbol = symbol
+	if err := info.Validate(); err != nil {
+		return types.ErrDenomRegistry(err.Error())
+	}
+	k.setDenomInfo(ctx, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(decimals), 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		),
+	)
+	return nil
+}
+
+// GetPendingDenomOwner returns the owner denom is being transferred to, empty if there is no pending transfer
+func (k Keeper) GetPendingDenomOwner(ctx sdk.Context, denom string) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(GetDenomPendingOwnerKey(denom))
+}
+
+// GetDenomOwnershipHistory returns the completed ownership transfers of denom, oldest first
+func (k Keeper) GetDenomOwnershipHistory(ctx sdk.Context, denom string) []types.DenomOwnershipTransfer {
+	history := make([]types.DenomOwnershipTransfer, 0)
+	if bz := ctx.KVStore(k.storeKey).Get(GetDenomOwnershipHistoryKey(denom)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &history)
+	}
+	return history
+}
+
+// TransferDenomOwnership proposes newOwner as the creator of denom on behalf of its current creator owner,
+// proposing owner itself cancels the pending transfer
+func (k Keeper) TransferDenomOwnership(ctx sdk.Context, owner sdk.AccAddress, denom string, newOwner sdk.AccAddress) error {
+	creator := k.GetDenomCreator(ctx, denom)
+	if len(creator) == 0 {
+		return types.ErrDenomRegistry(fmt.Sprintf("denom: %s is not registered", denom))
+	}
+	if !bytes.Equal(creator, owner) {
+		return types.ErrDenomRegistry(fmt.Sprintf("owner is not valid, expect: %s, got: %s", creator.String(), owner.String()))
+	}
+	store := ctx.KVStore(k.storeKey)
+	if bytes.Equal(owner, newOwner) {
+		store.Delete(GetDenomPendingOwnerKey(denom))
+	} else {
+		store.Set(GetDenomPendingOwnerKey(denom), newOwner.Bytes())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferDenomOwnership,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		),
+	)
+	return nil
+}
+
+// AcceptDenomOwnership makes newOwner the creator of denom, provided it is the owner proposed by the current creator
+func (k Keeper) AcceptDenomOwnership(ctx sdk.Context, newOwner sdk.AccAddress, denom string) error {
+	pendingOwner := k.GetPendingDenomOwner(ctx, denom)
+	if len(pendingOwner) == 0 {
+		return types.ErrDenomRegistry(fmt.Sprintf("denom: %s has no pending ownership transfer", denom))
+	}
+	if !bytes.Equal(pendingOwner, newOwner) {
+		return types.ErrDenomRegistry(fmt.Sprintf("new owner is not valid, expect: %s, got: %s", pendingOwner.String(), newOwner.String()))
+	}
+	previousOwner := k.GetDenomCreator(ctx, denom)
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(GetDenomInfoKey(denom)); bz != nil {
+		var info types.DenomInfo
+		k.cdc.MustUnmarshalBinaryBare(bz, &info)
+		info.Creator = newOwner
+		k.setDenomInfo(ctx, info)
+	} else {
+		store.Delete(GetDenomByCreatorKey(previousOwner, denom))
+		store.Set(GetDenomByCreatorKey(newOwner, denom), []byte{})
+	}
+	k.setDenomCreator(ctx, denom, newOwner)
+	store.Delete(GetDenomPendingOwnerKey(denom))
+
+	history := append(k.GetDenomOwnershipHistory(ctx, denom), types.DenomOwnershipTransfer{PreviousOwner: previousOwner, NewOwner: newOwner, Height: ctx.BlockHeight()})
+	store.Set(GetDenomOwnershipHistoryKey(denom), k.cdc.MustMarshalBinaryBare(history))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptDenomOwnership,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+)
+
+// Replay protection of the inbound cross chain txs is kept per fromChainId as
+//   - the done numeric crossChainIds under CrossChainDoneTxIdPrefix, ordered by value. A crossChainId is numeric
+//     if it is the big endian encoding of an uint64, zero padded or not up to 32 bytes as the EVM chains send it,
+//     so the differently padded encodings of an id all stand for the same tx
+//   - a high-water mark W: every numeric crossChainId below W is done, the done ids are pruned as W passes them
+//   - the done crossChainIds which are not numeric (e.g. hashes) under CrossChainDoneTxPrefix
+// The source chains number their txs with a growing counter shared by all their destinations, so the ids reaching
+// this chain are sparse and seldom contiguous. W is raised to DoneTxWindow ids below the highest id done, so that
+// at most DoneTxWindow ids are kept per fromChainId, a tx relayed that late is rejected as done.
+
+// numericCrossChainId returns the value of crossChainId if it is the, possibly zero padded, big endian encoding
+// of an uint64 of at most 32 bytes
+func numericCrossChainId(crossChainId []byte) (uint64, bool) {
+	if len(crossChainId) > 32 {
+		return 0, false
+	}
+	trimmed := bytes.TrimLeft(crossChainId, "\x00")
+	if len(trimmed) > 8 {
+		return 0, false
+	}
+	var id uint64
+	for _, b := range trimmed {
+		id = id<<8 | uint64(b)
+	}
+	return id, true
+}
+
+// GetDoneTxWindow returns how many ids below the highest done one the numeric crossChainIds of a fromChainId
+// are kept for, 0 keeps them all
+func (k Keeper) GetDoneTxWindow(ctx sdk.Context) uint64 {
+	window := types.DefaultDoneTxWindow
+	k.paramSpace.GetIfExists(ctx, types.KeyDoneTxWindow, &window)
+	return window
+}
+
+// GetDoneTxWatermark returns the high-water mark of the done cross chain txs from fromChainId
+func (k Keeper) GetDoneTxWatermark(ctx sdk.Context, fromChainId uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(GetDoneTxWatermarkKey(fromChainId))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setDoneTxWatermark(ctx sdk.Context, fromChainId uint64, watermark uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, watermark)
+	ctx.KVStore(k.storeKey).Set(GetDoneTxWatermarkKey(fromChainId), bz)
+}
+
+// IsDoneTx checks if the cross chain tx crossChainId from fromChainId has been processed
+func (k Keeper) IsDoneTx(ctx sdk.Context, fromChainId uint64, crossChainId []byte) bool {
+	if id, ok := numericCrossChainId(crossChainId); ok {
+		return id < k.GetDoneTxWatermark(ctx, fromChainId) || ctx.KVStore(k.storeKey).Has(GetDoneTxIdKey(fromChainId, id))
+	}
+	return ctx.KVStore(k.storeKey).Has(GetDoneTxKey(fromChainId, crossChainId))
+}
+
+func (k Keeper) checkDoneTx(ctx sdk.Context, fromChainId uint64, crossChainId []byte) error {
+	if id, ok := numericCrossChainId(crossChainId); ok {
+		if watermark := k.GetDoneTxWatermark(ctx, fromChainId); id < watermark {
+			return fmt.Errorf("checkDoneTx, tx: %d is below the done tx watermark: %d of chainId: %d", id, watermark, fromChainId)
+		}
+	}
+	if k.IsDoneTx(ctx, fromChainId, crossChainId) {
+		return fmt.Errorf("checkDoneTx, tx already done")
+	}
+	return nil
+}
+
+func (k Keeper) putDoneTx(ctx sdk.Context, fromChainId uint64, crossChainId []byte) {
+	id, ok := numericCrossChainId(crossChainId)
+	if !ok {
+		ctx.KVStore(k.storeKey).Set(GetDoneTxKey(fromChainId, crossChainId), crossChainId)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(GetDoneTxIdKey(fromChainId, id), crossChainId)
+	watermark := k.GetDoneTxWatermark(ctx, fromChainId)
+	if window := k.GetDoneTxWindow(ctx); window != 0 && id >= window && id-window+1 > watermark {
+		watermark = id - window + 1
+	}
+	k.advanceDoneTxWatermark(ctx, fromChainId, watermark)
+}
+
+// advanceDoneTxWatermark raises the high-water mark of fromChainId to watermark and then over the contiguous done
+// ids from there, the done ids it passes are pruned
+func (k Keeper) advanceDoneTxWatermark(ctx sdk.Context, fromChainId uint64, watermark uint64) {
+	store := ctx.KVStore(k.storeKey)
+	var covered [][]byte
+	iter := store.Iterator(GetDoneTxIdKey(fromChainId, 0), GetDoneTxIdKey(fromChainId, watermark))
+	for ; iter.Valid(); iter.Next() {
+		covered = append(covered, iter.Key())
+	}
+	iter.Close()
+	for _, key := range covered {
+		store.Delete(key)
+	}
+	for watermark != math.MaxUint64 {
+		key := GetDoneTxIdKey(fromChainId, watermark)
+		if !store.Has(key) {
+			break
+		}
+		store.Delete(key)
+		watermark++
+	}
+	if watermark != k.GetDoneTxWatermark(ctx, fromChainId) {
+		k.setDoneTxWatermark(ctx, fromChainId, watermark)
+	}
+}
+
+// MigrateDoneTxStore moves the numeric ids of the one key per tx entries written under CrossChainDoneTxPrefix to
+// CrossChainDoneTxIdPrefix, the other ones stay where they are. No id is assumed done but the ones in the store, so
+// the mark of a fromChainId only starts above 0 when its done ids span more than DoneTxWindow ids
+func (k Keeper) MigrateDoneTxStore(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	type entry struct {
+		key, crossChainId []byte
+		fromChainId, id   uint64
+	}
+	var entries []entry
+	iter := sdk.KVStorePrefixIterator(store, CrossChainDoneTxPrefix)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(CrossChainDoneTxPrefix):]
+		if len(key) < 8 {
+			continue
+		}
+		if id, ok := numericCrossChainId(key[8:]); ok {
+			entries = append(entries, entry{key: iter.Key(), crossChainId: key[8:], fromChainId: binary.LittleEndian.Uint64(key[:8]), id: id})
+		}
+	}
+	iter.Close()
+
+	var fromChainIds []uint64
+	highest := make(map[uint64]uint64)
+	for _, e := range entries {
+		store.Delete(e.key)
+		store.Set(GetDoneTxIdKey(e.fromChainId, e.id), e.crossChainId)
+		if high, seen := highest[e.fromChainId]; !seen || e.id > high {
+			if !seen {
+				fromChainIds = append(fromChainIds, e.fromChainId)
+			}
+			highest[e.fromChainId] = e.id
+		}
+	}
+
+	window := k.GetDoneTxWindow(ctx)
+	for _, fromChainId := range fromChainIds {
+		watermark := k.GetDoneTxWatermark(ctx, fromChainId)
+		if high := highest[fromChainId]; window != 0 && high >= window && high-window+1 > watermark {
+			watermark = high - window + 1
+		}
+		k.advanceDoneTxWatermark(ctx, fromChainId, watermark)
+	}
+}
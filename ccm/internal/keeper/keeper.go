@@ -18,18 +18,22 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common/telemetry"
+	"github.com/polynetwork/cosmos-poly-module/common/verifier"
 	hs "github.com/polynetwork/cosmos-poly-module/headersync"
 	polycommon "github.com/polynetwork/poly/common"
 	polytype "github.com/polynetwork/poly/core/types"
-	"github.com/polynetwork/poly/merkle"
 	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
@@ -45,6 +49,8 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 	ulKeeperMap  map[string]types.UnlockKeeper
 	assetKeeper  types.AssetKeeper
+	dataKeeper   types.DataKeeper
+	router       sdk.Router
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -73,6 +79,11 @@ func (k *Keeper) MountAssetKeeper(assetKeeper types.AssetKeeper) {
 	k.assetKeeper = assetKeeper
 }
 
+// MountDataKeeper sets the keeper the "storeData" cross chain txs are handed to
+func (k *Keeper) MountDataKeeper(dataKeeper types.DataKeeper) {
+	k.dataKeeper = dataKeeper
+}
+
 // GetParams returns the total set of ccm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -84,6 +95,21 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// MigrateParams stores the default value of every param missing from the param store, i.e. the params added after
+// the chain started, as reading the param set panics on a missing key. A missing chain registry is seeded with the
+// chains the txs were already sent to or received from, so that they are not rejected once the registry is checked
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	if !k.paramSpace.Has(ctx, types.KeyChainRegistry) {
+		defaults.ChainRegistry = k.getChainsInUse(ctx)
+	}
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
 func (k Keeper) IfContainToContract(ctx sdk.Context, keystore string, toContractAddr []byte, fromChainId uint64) *types.QueryContainToContractRes {
 	unlockKeeper, ok := k.ulKeeperMap[keystore]
 	if !ok {
@@ -98,7 +124,7 @@ func (k Keeper) IfContainToContract(ctx sdk.Context, keystore string, toContract
 	return &res
 }
 
-func (k Keeper) SetDenomCreator(ctx sdk.Context, denom string, creator sdk.AccAddress) {
+func (k Keeper) setDenomCreator(ctx sdk.Context, denom string, creator sdk.AccAddress) {
 	ctx.KVStore(k.storeKey).Set(GetDenomToCreatorKey(denom), creator.Bytes())
 }
 
@@ -106,6 +132,8 @@ func (k Keeper) GetDenomCreator(ctx sdk.Context, denom string) sdk.AccAddress {
 	return ctx.KVStore(k.storeKey).Get(GetDenomToCreatorKey(denom))
 }
 
+// ExistDenom checks if denom is registered or, for the denoms not created through the bridge modules such as the
+// staking denom, if it has any supply
 func (k Keeper) ExistDenom(ctx sdk.Context, denom string) (string, bool) {
 	storedSupplyCoins := k.supplyKeeper.GetSupply(ctx).GetTotal()
 	//return storedSupplyCoins.AmountOf(denom) != sdk.ZeroInt() || len(k.GetOperator(ctx, denom)) != 0
@@ -127,7 +155,151 @@ func (k Keeper) GetModuleBalance(ctx sdk.Context, moduleName string) (sdk.Coins,
 	return moduleAcct.GetCoins(), nil
 }
 
+// GetChainRegistry returns the destination chains registered through governance, it is empty if never set, in which
+// case the cross chain txs towards any chain are rejected
+func (k Keeper) GetChainRegistry(ctx sdk.Context) []types.ChainInfo {
+	var chains []types.ChainInfo
+	k.paramSpace.GetIfExists(ctx, types.KeyChainRegistry, &chains)
+	return chains
+}
+
+// SetChainRegistry replaces the registered destination chains, it is meant for genesis and upgrade handlers,
+// later changes are expected to go through param change proposals
+func (k Keeper) SetChainRegistry(ctx sdk.Context, chains []types.ChainInfo) {
+	k.paramSpace.Set(ctx, types.KeyChainRegistry, chains)
+}
+
+func (k Keeper) GetChainInfo(ctx sdk.Context, chainId uint64) (types.ChainInfo, bool) {
+	for _, chain := range k.GetChainRegistry(ctx) {
+		if chain.ChainId == chainId {
+			return chain, true
+		}
+	}
+	return types.ChainInfo{}, false
+}
+
+func (k Keeper) getEnabledChainInfo(ctx sdk.Context, chainId uint64) (types.ChainInfo, error) {
+	chain, ok := k.GetChainInfo(ctx, chainId)
+	if !ok {
+		return chain, types.ErrChainRegistry(fmt.Sprintf("chainId: %d is not registered", chainId))
+	}
+	if !chain.Enabled {
+		return chain, types.ErrChainRegistry(fmt.Sprintf("chainId: %d (%s) is disabled", chainId, chain.Name))
+	}
+	return chain, nil
+}
+
+// getChainsInUse returns the chains found in the outgoing tx details, the per destination sequences and the done
+// txs, registered with the loosest address and amount format, which checks no more than before the registry
+func (k Keeper) getChainsInUse(ctx sdk.Context) []types.ChainInfo {
+	store := ctx.KVStore(k.storeKey)
+	inUse := make(map[uint64]bool)
+
+	iter := sdk.KVStorePrefixIterator(store, CrossChainTxDetailPrefix)
+	for ; iter.Valid(); iter.Next() {
+		txParam := new(ccmc.MakeTxParam)
+		if err := txParam.Deserialization(polycommon.NewZeroCopySource(iter.Value())); err == nil {
+			inUse[txParam.ToChainID] = true
+		}
+	}
+	iter.Close()
+
+	for _, prefix := range [][]byte{CrossChainSequencePrefix, CrossChainDoneTxWatermarkPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			inUse[binary.BigEndian.Uint64(iter.Key()[len(prefix):])] = true
+		}
+		iter.Close()
+	}
+
+	// the done txs of a chain share a prefix, so only their first key is read before skipping to the next chain
+	for _, done := range []struct {
+		prefix      []byte
+		fromChainId func([]byte) uint64
+		chainPrefix func(uint64) []byte
+	}{
+		{CrossChainDoneTxPrefix, binary.LittleEndian.Uint64, GetDoneTxChainPrefix},
+		{CrossChainDoneTxIdPrefix, binary.BigEndian.Uint64, GetDoneTxIdChainPrefix},
+	} {
+		start, end := done.prefix, sdk.PrefixEndBytes(done.prefix)
+		for start != nil {
+			iter := store.Iterator(start, end)
+			if !iter.Valid() {
+				iter.Close()
+				break
+			}
+			key := iter.Key()[len(done.prefix):]
+			iter.Close()
+			if len(key) < 8 {
+				break
+			}
+			fromChainId := done.fromChainId(key[:8])
+			inUse[fromChainId] = true
+			start = sdk.PrefixEndBytes(done.chainPrefix(fromChainId))
+		}
+	}
+
+	chainIds := make([]uint64, 0, len(inUse))
+	for chainId := range inUse {
+		if chainId != 0 {
+			chainIds = append(chainIds, chainId)
+		}
+	}
+	sort.Slice(chainIds, func(i, j int) bool { return chainIds[i] < chainIds[j] })
+	chains := make([]types.ChainInfo, 0, len(chainIds))
+	for _, chainId := range chainIds {
+		chains = append(chains, types.NewChainInfo(chainId, fmt.Sprintf("chain-%d", chainId), types.VMFamilyOther, 0, types.AddressEncodingRaw, types.MaxAmountIntWidth, true))
+	}
+	return chains
+}
+
+// ValidateToAddress checks toAddr is a well formed address of the registered and enabled chain toChainId
+func (k Keeper) ValidateToAddress(ctx sdk.Context, toChainId uint64, toAddr []byte) error {
+	chain, err := k.getEnabledChainInfo(ctx, toChainId)
+	if err != nil {
+		return err
+	}
+	if err := chain.ValidateAddress(toAddr); err != nil {
+		return types.ErrChainRegistry(err.Error())
+	}
+	return nil
+}
+
+// ValidateToAmount checks amount can be represented by the amount integer of the registered and enabled chain toChainId
+func (k Keeper) ValidateToAmount(ctx sdk.Context, toChainId uint64, amount sdk.Int) error {
+	chain, err := k.getEnabledChainInfo(ctx, toChainId)
+	if err != nil {
+		return err
+	}
+	if err := chain.ValidateAmount(amount.BigInt()); err != nil {
+		return types.ErrChainRegistry(err.Error())
+	}
+	return nil
+}
+
+// IsCrossChainCallAllowed checks the governance managed allowlist for accounts creating cross chain txs directly
+func (k Keeper) IsCrossChainCallAllowed(ctx sdk.Context, toChainId uint64, toContractHash []byte, method string) bool {
+	for _, call := range k.GetParams(ctx).AllowedCrossChainCalls {
+		if call.Matches(toChainId, toContractHash, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateCrossChainTxFromSender creates a cross chain tx on behalf of an account, the call must be allowlisted
+// and the FromContractAddress is derived from the sender
+func (k Keeper) CreateCrossChainTxFromSender(ctx sdk.Context, sender sdk.AccAddress, toChainId uint64, toContractHash []byte, method string, args []byte) error {
+	if !k.IsCrossChainCallAllowed(ctx, toChainId, toContractHash, method) {
+		return types.ErrCreateCrossChainTx(fmt.Sprintf("call of method: %s on contract: %x of chainId: %d is not allowed", method, toContractHash, toChainId))
+	}
+	return k.CreateCrossChainTx(ctx, sender, toChainId, types.GetSenderContractHash(sender), toContractHash, method, args)
+}
+
 func (k Keeper) CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toChainId uint64, fromContractHash, toContractHash []byte, method string, args []byte) error {
+	if _, err := k.getEnabledChainInfo(ctx, toChainId); err != nil {
+		return err
+	}
 	crossChainId, err := k.getCrossChainId(ctx)
 	if err != nil {
 		return err
@@ -135,6 +307,8 @@ func (k Keeper) CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toC
 	if err := k.setCrossChainId(ctx, crossChainId.Add(sdk.NewInt(1))); err != nil {
 		return err
 	}
+	sequence := k.GetCrossChainSequence(ctx, toChainId)
+	k.setCrossChainSequence(ctx, toChainId, sequence+1)
 
 	ttx := make([]byte, len(ctx.TxBytes()))
 	copy(ttx, ctx.TxBytes())
@@ -156,6 +330,9 @@ func (k Keeper) CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toC
 
 	txParamHash := tmhash.Sum(sink.Bytes())
 	store.Set(GetCrossChainTxKey(txParamHash), sink.Bytes())
+	store.Set(GetCrossChainSequenceTxKey(toChainId, sequence), txParamHash)
+	k.indexCrossChainTx(ctx, txParamHash)
+	telemetry.RecordOutgoingTx(ctx, toChainId, len(args))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -166,6 +343,7 @@ func (k Keeper) CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toC
 			sdk.NewAttribute(types.AttributeKeyFromAddress, fromAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyFromContract, hex.EncodeToString(fromContractHash)),
 			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
 			sdk.NewAttribute(types.AttributeKeyMakeTxParam, hex.EncodeToString(sink.Bytes())),
 		),
 	})
@@ -193,7 +371,24 @@ func (k Keeper) ProcessRegisterAssetTx(ctx sdk.Context, merkleValue *ccmc.ToMerk
 	return k.assetKeeper.RegisterAsset(ctx, merkleValue.FromChainID, merkleValue.MakeTxParam.FromContractAddress, merkleValue.MakeTxParam.ToContractAddress, merkleValue.MakeTxParam.Args)
 }
 
-func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofStr string, headerStr, headerProofStr, curHeaderStr string) error {
+// ProcessStoreDataTx hands the "storeData" cross chain tx, proven against the Poly header at polyHeight, to the data keeper
+func (k Keeper) ProcessStoreDataTx(ctx sdk.Context, merkleValue *ccmc.ToMerkleValue, polyHeight uint32) error {
+	if k.dataKeeper == nil {
+		return types.ErrProcessCrossChainTx(fmt.Sprintf("no data keeper is mounted to perform '%s' method", types.MethodStoreData))
+	}
+	return k.dataKeeper.StoreData(ctx, merkleValue.FromChainID, merkleValue.MakeTxParam.FromContractAddress, merkleValue.MakeTxParam.ToContractAddress, merkleValue.MakeTxParam.Args, polyHeight)
+}
+
+func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofStr string, headerStr, headerProofStr, curHeaderStr string) (err error) {
+	// stage is the error class reported to telemetry if processing fails
+	start, stage := time.Now(), "decode_header"
+	defer func() {
+		if err == nil {
+			stage = ""
+		}
+		telemetry.RecordInboundTx(ctx, fromChainId, stage, start)
+	}()
+
 	headerToBeVerified := new(polytype.Header)
 	headerBs, err := hex.DecodeString(headerStr)
 	if err != nil {
@@ -218,10 +413,12 @@ func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofSt
 		headerProof = nil
 	}
 
+	stage = "process_header"
 	if err := k.hsKeeper.ProcessHeader(ctx, headerToBeVerified, headerProof, headerInCurEpoch); err != nil {
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("ProcessHeader Error, %s", err.Error()))
 	}
 
+	stage = "verify_proof"
 	proof, err := hex.DecodeString(proofStr)
 	if err != nil {
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("Decode proof hex string: %s to bytes, Error: %s", proofStr, err.Error()))
@@ -231,30 +428,53 @@ func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofSt
 	if err != nil {
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("VerifyToCosmostx failed, %s", err.Error()))
 	}
+	stage = "to_chain_id"
 	currentChainCrossChainId := k.GetParams(ctx).ChainIdInPolyNet
 	if merkleValue.MakeTxParam.ToChainID != currentChainCrossChainId {
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("toChainId is not for this chain, expect: %d, got: %d", currentChainCrossChainId, merkleValue.MakeTxParam.ToChainID))
 	}
 
+	stage = merkleValue.MakeTxParam.Method
 	switch merkleValue.MakeTxParam.Method {
 	case "unlock":
 		return k.ProcessUnlockTx(ctx, merkleValue, fromChainId)
 	case "registerAsset":
 		return k.ProcessRegisterAssetTx(ctx, merkleValue)
+	case types.MethodExecute:
+		return k.ProcessExecuteTx(ctx, merkleValue)
+	case types.MethodStoreData:
+		return k.ProcessStoreDataTx(ctx, merkleValue, headerToBeVerified.Height)
 	default:
+		stage = "unsupported_method"
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("unsupported cross-chain method: %s", merkleValue.MakeTxParam.Method))
 	}
 }
 
-func (k Keeper) VerifyToCosmosTx(ctx sdk.Context, proof []byte, header *polytype.Header) (*ccmc.ToMerkleValue, error) {
-	value, err := merkle.MerkleProve(proof, header.CrossStateRoot[:])
+// PrecheckCrossChainTx tells, without verifying the proof, if the cross chain tx carried by proofStr would be
+// rejected for being already done or for targeting another chain. The leaf value of a merkle proof comes first
+// in it, so this is cheap enough to run on every submission in CheckTx
+func (k Keeper) PrecheckCrossChainTx(ctx sdk.Context, proofStr string) error {
+	proof, err := hex.DecodeString(proofStr)
+	if err != nil {
+		return types.ErrProcessCrossChainTx(fmt.Sprintf("Decode proof hex string: %s to bytes, Error: %s", proofStr, err.Error()))
+	}
+	merkleValue, err := verifier.PeekToMerkleValue(proof)
 	if err != nil {
-		return nil, types.ErrVerifyToCosmosTx(fmt.Sprintf("merkle.MerkleProve verify failed, Error: %s", err.Error()))
+		return types.ErrProcessCrossChainTx(err.Error())
 	}
+	if currentChainCrossChainId := k.GetParams(ctx).ChainIdInPolyNet; merkleValue.MakeTxParam.ToChainID != currentChainCrossChainId {
+		return types.ErrProcessCrossChainTx(fmt.Sprintf("toChainId is not for this chain, expect: %d, got: %d", currentChainCrossChainId, merkleValue.MakeTxParam.ToChainID))
+	}
+	if err := k.checkDoneTx(ctx, merkleValue.FromChainID, merkleValue.MakeTxParam.CrossChainID); err != nil {
+		return types.ErrProcessCrossChainTx(fmt.Sprintf("fromChainId: %d, crossChainId: %x, Error: %s", merkleValue.FromChainID, merkleValue.MakeTxParam.CrossChainID, err.Error()))
+	}
+	return nil
+}
 
-	merkleValue := new(ccmc.ToMerkleValue)
-	if err := merkleValue.Deserialization(polycommon.NewZeroCopySource(value)); err != nil {
-		return nil, types.ErrVerifyToCosmosTx(fmt.Sprintf("ToMerkeValue Deserialization Error: %s", err.Error()))
+func (k Keeper) VerifyToCosmosTx(ctx sdk.Context, proof []byte, header *polytype.Header) (*ccmc.ToMerkleValue, error) {
+	merkleValue, err := verifier.VerifyToCosmosTx(proof, header)
+	if err != nil {
+		return nil, types.ErrVerifyToCosmosTx(err.Error())
 	}
 
 	if err := k.checkDoneTx(ctx, merkleValue.FromChainID, merkleValue.MakeTxParam.CrossChainID); err != nil {
@@ -276,19 +496,6 @@ func (k Keeper) VerifyToCosmosTx(ctx sdk.Context, proof []byte, header *polytype
 
 }
 
-func (k Keeper) checkDoneTx(ctx sdk.Context, fromChainId uint64, crossChainId []byte) error {
-	store := ctx.KVStore(k.storeKey)
-	value := store.Get(GetDoneTxKey(fromChainId, crossChainId))
-	if value != nil {
-		return fmt.Errorf("checkDoneTx, tx already done")
-	}
-	return nil
-}
-func (k Keeper) putDoneTx(ctx sdk.Context, fromChainId uint64, crossChainId []byte) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(GetDoneTxKey(fromChainId, crossChainId), crossChainId)
-}
-
 func (k Keeper) getCrossChainId(ctx sdk.Context) (sdk.Int, error) {
 	store := ctx.KVStore(k.storeKey)
 	idBs := store.Get(CrossChainIdKey)
@@ -311,3 +518,30 @@ func (k Keeper) setCrossChainId(ctx sdk.Context, crossChainId sdk.Int) error {
 	store.Set(CrossChainIdKey, idBs)
 	return nil
 }
+
+// GetCrossChainSequence returns the sequence the next cross chain tx towards toChainId will be assigned,
+// which is also the number of cross chain txs created towards toChainId so far
+func (k Keeper) GetCrossChainSequence(ctx sdk.Context, toChainId uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(GetCrossChainSequenceKey(toChainId))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setCrossChainSequence(ctx sdk.Context, toChainId uint64, sequence uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	ctx.KVStore(k.storeKey).Set(GetCrossChainSequenceKey(toChainId), bz)
+}
+
+// GetCrossChainTxBySequence returns the hash and the serialized MakeTxParam of the cross chain tx assigned sequence towards toChainId,
+// makeTxParam is nil once the tx detail has been pruned
+func (k Keeper) GetCrossChainTxBySequence(ctx sdk.Context, toChainId, sequence uint64) (txParamHash, makeTxParam []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	txParamHash = store.Get(GetCrossChainSequenceTxKey(toChainId, sequence))
+	if txParamHash == nil {
+		return nil, nil, false
+	}
+	return txParamHash, store.Get(GetCrossChainTxKey(txParamHash)), true
+}
@@ -0,0 +1,800 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/headersync"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	params := ccm.DefaultGenesisState().Params
+	params.ChainIdInPolyNet = 5
+	params.ChainRegistry = []ccm.ChainInfo{
+		ccm.NewChainInfo(2, "ethereum", ccm.VMFamilyEVM, 20, ccm.AddressEncodingRaw, 32, true),
+		ccm.NewChainInfo(3, "neo", ccm.VMFamilyNeoVM, 20, ccm.AddressEncodingRaw, 32, true),
+		ccm.NewChainInfo(4, "disabled", ccm.VMFamilyEVM, 20, ccm.AddressEncodingRaw, 32, false),
+		ccm.NewChainInfo(6, "narrow", ccm.VMFamilyUTXO, 0, ccm.AddressEncodingRaw, 8, true),
+	}
+	app.CcmKeeper.SetParams(ctx, params)
+	return app, ctx
+}
+
+func Test_ccm_CreateCrossChainTxFromSender(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	sender := sdk.AccAddress([]byte("sender"))
+	toContract, _ := hex.DecodeString("c330431496364497d7257839737b5e4596f5ac06")
+	params := app.CcmKeeper.GetParams(ctx)
+	params.AllowedCrossChainCalls = []ccm.AllowedCrossChainCall{ccm.NewAllowedCrossChainCall(2, toContract, "deposit")}
+	app.CcmKeeper.SetParams(ctx, params)
+
+	testCases := []struct {
+		toChainId     uint64
+		toContract    []byte
+		method        string
+		expectSucceed bool
+	}{
+		{2, toContract, "deposit", true},
+		{3, toContract, "deposit", false},
+		{2, []byte{1, 2, 3}, "deposit", false},
+		{2, toContract, "unlock", false},
+	}
+	for _, testCase := range testCases {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err := app.CcmKeeper.CreateCrossChainTxFromSender(ctx, sender, testCase.toChainId, testCase.toContract, testCase.method, []byte{1})
+		if !testCase.expectSucceed {
+			require.Error(t, err)
+			continue
+		}
+		require.Nil(t, err)
+		events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+		require.Equal(t, ccm.EventTypeCreateCrossChainTx, events[0].Type)
+		fromContract := hex.EncodeToString(ccm.GetSenderContractHash(sender))
+		require.Contains(t, events[0].Attributes, sdk.Attribute{Key: ccm.AttributeKeyFromContract, Value: fromContract})
+		require.NotEqual(t, hex.EncodeToString(sender.Bytes()), fromContract, "sender derived hash should differ from sender address")
+	}
+}
+
+func Test_ccm_ChainRegistry(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	info, found := app.CcmKeeper.GetChainInfo(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, "ethereum", info.Name)
+	_, found = app.CcmKeeper.GetChainInfo(ctx, 7)
+	require.False(t, found)
+
+	addr20 := make([]byte, 20)
+	addrTestCases := []struct {
+		toChainId     uint64
+		toAddr        []byte
+		expectSucceed bool
+	}{
+		{2, addr20, true},
+		{2, addr20[:19], false},
+		{4, addr20, false},
+		{7, addr20, false},
+		{6, []byte{1, 2, 3}, true},
+		{6, []byte{}, false},
+	}
+	for _, testCase := range addrTestCases {
+		err := app.CcmKeeper.ValidateToAddress(ctx, testCase.toChainId, testCase.toAddr)
+		if testCase.expectSucceed {
+			require.Nil(t, err, "chainId: %d, toAddr: %x", testCase.toChainId, testCase.toAddr)
+		} else {
+			require.Error(t, err, "chainId: %d, toAddr: %x", testCase.toChainId, testCase.toAddr)
+		}
+	}
+
+	maxUint64 := sdk.NewIntFromUint64(^uint64(0))
+	require.Nil(t, app.CcmKeeper.ValidateToAmount(ctx, 6, maxUint64))
+	require.Error(t, app.CcmKeeper.ValidateToAmount(ctx, 6, maxUint64.AddRaw(1)))
+	require.Nil(t, app.CcmKeeper.ValidateToAmount(ctx, 2, maxUint64.AddRaw(1)))
+
+	require.Error(t, app.CcmKeeper.CreateCrossChainTx(ctx, sdk.AccAddress([]byte("sender")), 4, []byte{1}, addr20, "unlock", []byte{1}))
+
+	// the empty registry rejects the txs towards any chain
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{})
+	require.Error(t, app.CcmKeeper.ValidateToAddress(ctx, 2, addr20))
+	require.Error(t, app.CcmKeeper.ValidateToAmount(ctx, 2, sdk.OneInt()))
+	require.Error(t, app.CcmKeeper.CreateCrossChainTx(ctx, sdk.AccAddress([]byte("sender")), 2, []byte{1}, addr20, "unlock", []byte{1}))
+}
+
+func Test_ccm_CrossChainSequence(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	fromAddr := sdk.AccAddress([]byte("sender"))
+	toContract := make([]byte, 20)
+	toChainIds := []uint64{2, 3, 2, 2, 3}
+	expectSequences := []uint64{0, 0, 1, 2, 1}
+	for i, toChainId := range toChainIds {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, fromAddr, toChainId, []byte{1}, toContract, "unlock", []byte{byte(i)}))
+		events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+		require.Equal(t, ccm.EventTypeCreateCrossChainTx, events[0].Type)
+		require.Contains(t, events[0].Attributes, sdk.Attribute{Key: ccm.AttributeKeySequence, Value: strconv.FormatUint(expectSequences[i], 10)})
+
+		txParamHash, makeTxParam, found := app.CcmKeeper.GetCrossChainTxBySequence(ctx, toChainId, expectSequences[i])
+		require.True(t, found)
+		require.Contains(t, events[0].Attributes, sdk.Attribute{Key: ccm.AttributeKeyTxParamHash, Value: hex.EncodeToString(txParamHash)})
+		require.Contains(t, events[0].Attributes, sdk.Attribute{Key: ccm.AttributeKeyMakeTxParam, Value: hex.EncodeToString(makeTxParam)})
+	}
+	require.Equal(t, uint64(3), app.CcmKeeper.GetCrossChainSequence(ctx, 2))
+	require.Equal(t, uint64(2), app.CcmKeeper.GetCrossChainSequence(ctx, 3))
+	require.Equal(t, uint64(0), app.CcmKeeper.GetCrossChainSequence(ctx, 6))
+	_, _, found := app.CcmKeeper.GetCrossChainTxBySequence(ctx, 2, 3)
+	require.False(t, found)
+
+	// a rejected tx must not consume a sequence
+	require.Error(t, app.CcmKeeper.CreateCrossChainTx(ctx, fromAddr, 4, []byte{1}, toContract, "unlock", []byte{1}))
+	require.Equal(t, uint64(0), app.CcmKeeper.GetCrossChainSequence(ctx, 4))
+}
+
+// evmCrossChainId is the 32 bytes zero padded id the EVM chains send
+func evmCrossChainId(id uint64) []byte {
+	bz := make([]byte, 32)
+	binary.BigEndian.PutUint64(bz[24:], id)
+	return bz
+}
+
+// doneTxKeys counts the keys kept for the done txs of fromChainId
+func doneTxKeys(store sdk.KVStore, fromChainId uint64) (n int) {
+	for _, prefix := range [][]byte{ccm.GetDoneTxChainPrefix(fromChainId), ccm.GetDoneTxIdChainPrefix(fromChainId)} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			n++
+		}
+		iter.Close()
+	}
+	return n
+}
+
+func Test_ccm_DoneTx(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+	store := ctx.KVStore(app.GetKey(ccm.StoreKey))
+
+	ids := [][]byte{{}, {2}, {1}, {0x01, 0x00}, {0x00, 0x03}, {0xff}, make([]byte, 33)}
+	for _, id := range ids {
+		require.Nil(t, keeper.CheckDoneTx(k, ctx, 2, id), "id: %x", id)
+		keeper.PutDoneTx(k, ctx, 2, id)
+		require.Error(t, keeper.CheckDoneTx(k, ctx, 2, id), "id: %x", id)
+	}
+	// 0, 1, 2, 3 are contiguous whatever their padding, 0xff, 0x100 and the non numeric id stay in the store
+	require.Equal(t, uint64(4), k.GetDoneTxWatermark(ctx, 2))
+	require.False(t, store.Has(ccm.GetDoneTxIdKey(2, 2)))
+	require.True(t, store.Has(ccm.GetDoneTxIdKey(2, 0x100)))
+	require.True(t, store.Has(ccm.GetDoneTxKey(2, make([]byte, 33))))
+	require.Equal(t, 3, doneTxKeys(store, 2))
+
+	// the padded encodings of a done id are done too
+	for _, id := range [][]byte{{0x00}, {0x00, 0x02}, evmCrossChainId(3), {0x00, 0xff}} {
+		require.True(t, k.IsDoneTx(ctx, 2, id), "id: %x", id)
+	}
+	for _, id := range [][]byte{{4}, {0xfe}, evmCrossChainId(4), make([]byte, 34)} {
+		require.False(t, k.IsDoneTx(ctx, 2, id), "id: %x", id)
+	}
+	for _, id := range ids {
+		require.False(t, k.IsDoneTx(ctx, 3, id), "id: %x", id)
+	}
+}
+
+func Test_ccm_DoneTxWindow(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+	store := ctx.KVStore(app.GetKey(ccm.StoreKey))
+	require.Equal(t, ccm.DefaultDoneTxWindow, k.GetDoneTxWindow(ctx))
+	params := k.GetParams(ctx)
+	params.DoneTxWindow = 100
+	k.SetParams(ctx, params)
+
+	// chain 2 numbers the txs towards all its destinations with one counter, the ones reaching this chain are
+	// seldom contiguous and the mark only moves through the window
+	for id := uint64(1); id <= 5000; id += 7 {
+		require.Nil(t, keeper.CheckDoneTx(k, ctx, 2, evmCrossChainId(id)), "id: %d", id)
+		keeper.PutDoneTx(k, ctx, 2, evmCrossChainId(id))
+		require.LessOrEqual(t, doneTxKeys(store, 2), 15, "id: %d", id)
+	}
+	// the highest id done is 4999, the ids of the window are kept and the gaps in it may still be processed
+	require.Equal(t, uint64(4900), k.GetDoneTxWatermark(ctx, 2))
+	require.True(t, k.IsDoneTx(ctx, 2, evmCrossChainId(4992)))
+	require.Nil(t, keeper.CheckDoneTx(k, ctx, 2, evmCrossChainId(4993)))
+	keeper.PutDoneTx(k, ctx, 2, evmCrossChainId(4993))
+	require.True(t, k.IsDoneTx(ctx, 2, evmCrossChainId(4993)))
+	// a tx relayed later than the window is rejected
+	err := keeper.CheckDoneTx(k, ctx, 2, evmCrossChainId(4899))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "watermark")
+
+	// a window of 0 keeps every id
+	params.DoneTxWindow = 0
+	k.SetParams(ctx, params)
+	for id := uint64(1); id <= 5000; id += 7 {
+		keeper.PutDoneTx(k, ctx, 3, evmCrossChainId(id))
+	}
+	require.Equal(t, uint64(0), k.GetDoneTxWatermark(ctx, 3))
+	require.Equal(t, 715, doneTxKeys(store, 3))
+}
+
+func Test_ccm_MigrateDoneTxStore(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+	store := ctx.KVStore(app.GetKey(ccm.StoreKey))
+
+	// the layout written before the high-water mark existed, one key per done tx. The EVM chain 2 numbers the
+	// txs towards all its destinations with one counter, so its ids towards this chain have gaps
+	legacy := map[uint64][][]byte{
+		2: {evmCrossChainId(1000), evmCrossChainId(1001), evmCrossChainId(1004), evmCrossChainId(1002), make([]byte, 33)},
+		3: {{1}, {2}},
+	}
+	for fromChainId, ids := range legacy {
+		for _, id := range ids {
+			store.Set(ccm.GetDoneTxKey(fromChainId, id), id)
+		}
+	}
+	k.MigrateDoneTxStore(ctx)
+
+	// the done ids are moved unchanged, no id is assumed done but them
+	require.Equal(t, uint64(0), k.GetDoneTxWatermark(ctx, 2))
+	require.Equal(t, uint64(0), k.GetDoneTxWatermark(ctx, 3))
+	require.Equal(t, 5, doneTxKeys(store, 2))
+	require.Equal(t, 2, doneTxKeys(store, 3))
+	require.True(t, store.Has(ccm.GetDoneTxKey(2, make([]byte, 33))))
+	require.False(t, store.Has(ccm.GetDoneTxKey(2, evmCrossChainId(1000))))
+	for fromChainId, ids := range legacy {
+		for _, id := range ids {
+			require.True(t, k.IsDoneTx(ctx, fromChainId, id), "fromChainId: %d, id: %x", fromChainId, id)
+		}
+	}
+
+	// a lower id still in flight at the migration and the gaps are processed after it
+	for _, id := range []uint64{999, 1003, 5} {
+		require.Nil(t, keeper.CheckDoneTx(k, ctx, 2, evmCrossChainId(id)), "id: %d", id)
+		keeper.PutDoneTx(k, ctx, 2, evmCrossChainId(id))
+		require.True(t, k.IsDoneTx(ctx, 2, evmCrossChainId(id)), "id: %d", id)
+	}
+	require.Nil(t, keeper.CheckDoneTx(k, ctx, 3, []byte{0}))
+	keeper.PutDoneTx(k, ctx, 3, []byte{0})
+	require.Equal(t, uint64(3), k.GetDoneTxWatermark(ctx, 3))
+	require.Equal(t, 0, doneTxKeys(store, 3))
+
+	// the window applies to the migrated ids
+	params := k.GetParams(ctx)
+	params.DoneTxWindow = 2
+	k.SetParams(ctx, params)
+	store.Set(ccm.GetDoneTxKey(4, evmCrossChainId(10)), evmCrossChainId(10))
+	store.Set(ccm.GetDoneTxKey(4, evmCrossChainId(20)), evmCrossChainId(20))
+	k.MigrateDoneTxStore(ctx)
+	require.Equal(t, uint64(19), k.GetDoneTxWatermark(ctx, 4))
+	require.Equal(t, 1, doneTxKeys(store, 4))
+	require.True(t, k.IsDoneTx(ctx, 4, evmCrossChainId(10)))
+}
+
+func Test_ccm_MigrateParams(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+	current := k.GetParams(ctx)
+
+	// the txs sent before the chain registry existed, towards chains 2 and 5
+	k.SetChainRegistry(ctx, []ccm.ChainInfo{
+		ccm.NewChainInfo(2, "ethereum", ccm.VMFamilyEVM, 20, ccm.AddressEncodingRaw, 32, true),
+		ccm.NewChainInfo(5, "neo", ccm.VMFamilyNeoVM, 20, ccm.AddressEncodingRaw, 32, true),
+	})
+	for _, toChainId := range []uint64{5, 2, 5} {
+		require.Nil(t, k.CreateCrossChainTx(ctx, sdk.AccAddress([]byte("sender")), toChainId, []byte{1}, []byte{2}, "unlock", []byte{3}))
+	}
+
+	// the param store of a chain started before the params were added
+	paramStore := ctx.KVStore(app.GetKey(params.StoreKey))
+	added := [][]byte{ccm.KeyAllowedCrossChainCalls, ccm.KeyChainRegistry, ccm.KeyTxDetailRetentionBlocks, ccm.KeyRelayFeeWaiverLimit, ccm.KeyRelayFeeWaiverWindow, ccm.KeyAllowedRemoteMsgTypes, ccm.KeyDoneTxWindow}
+	for _, key := range added {
+		paramStore.Delete(append([]byte(ccm.DefaultParamspace+"/"), key...))
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	k.MigrateParams(ctx)
+	migrated := k.GetParams(ctx)
+	require.Equal(t, current.ChainIdInPolyNet, migrated.ChainIdInPolyNet)
+	require.Empty(t, migrated.AllowedCrossChainCalls)
+	// the chains already in use are registered with the loosest format, any other chain is rejected
+	require.Equal(t, []ccm.ChainInfo{
+		ccm.NewChainInfo(2, "chain-2", ccm.VMFamilyOther, 0, ccm.AddressEncodingRaw, ccm.MaxAmountIntWidth, true),
+		ccm.NewChainInfo(5, "chain-5", ccm.VMFamilyOther, 0, ccm.AddressEncodingRaw, ccm.MaxAmountIntWidth, true),
+	}, migrated.ChainRegistry)
+	require.Nil(t, k.ValidateToAddress(ctx, 2, []byte{1, 2, 3}))
+	require.Error(t, k.ValidateToAddress(ctx, 3, []byte{1, 2, 3}))
+	require.Empty(t, migrated.AllowedRemoteMsgTypes)
+	require.Equal(t, ccm.DefaultRelayFeeWaiverWindow, migrated.RelayFeeWaiverWindow)
+	require.Equal(t, ccm.DefaultDoneTxWindow, migrated.DoneTxWindow)
+	require.Nil(t, migrated.Validate())
+
+	// the params already in the store are kept
+	current.RelayFeeWaiverLimit = 7
+	k.SetParams(ctx, current)
+	k.MigrateParams(ctx)
+	require.Equal(t, current, k.GetParams(ctx))
+}
+
+func Test_ccm_PruneCrossChainTxDetails(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := app.CcmKeeper.GetParams(ctx)
+	params.TxDetailRetentionBlocks = 10
+	app.CcmKeeper.SetParams(ctx, params)
+
+	fromAddr := sdk.AccAddress([]byte("sender"))
+	toContract := make([]byte, 20)
+	heights := []int64{1, 5, 12}
+	txParamHashes := make([][]byte, len(heights))
+	for i, height := range heights {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, fromAddr, 2, []byte{1}, toContract, "unlock", []byte{byte(i)}))
+		txParamHashes[i], _, _ = app.CcmKeeper.GetCrossChainTxBySequence(ctx, 2, uint64(i))
+		ccm.EndBlocker(ctx, app.CcmKeeper)
+	}
+	require.Equal(t, int64(2), app.CcmKeeper.GetTxDetailRetainedHeight(ctx))
+
+	ctx = ctx.WithBlockHeight(15)
+	ccm.EndBlocker(ctx, app.CcmKeeper)
+	require.Equal(t, int64(5), app.CcmKeeper.GetTxDetailRetainedHeight(ctx))
+
+	makeTxParam, height, found := app.CcmKeeper.GetCrossChainTx(ctx, txParamHashes[0])
+	require.True(t, found)
+	require.Nil(t, makeTxParam)
+	require.Equal(t, int64(1), height)
+	for i := 1; i < len(heights); i++ {
+		makeTxParam, height, found := app.CcmKeeper.GetCrossChainTx(ctx, txParamHashes[i])
+		require.True(t, found)
+		require.NotNil(t, makeTxParam)
+		require.Equal(t, heights[i], height)
+	}
+	txParamHash, makeTxParam, found := app.CcmKeeper.GetCrossChainTxBySequence(ctx, 2, 0)
+	require.True(t, found)
+	require.Equal(t, txParamHashes[0], txParamHash)
+	require.Nil(t, makeTxParam)
+
+	_, _, found = app.CcmKeeper.GetCrossChainTx(ctx, []byte{1, 2, 3})
+	require.False(t, found)
+}
+
+func Test_ccm_PruneCrossChainTxDetails_Budget(t *testing.T) {
+	app, ctx := createTestApp(true)
+	fromAddr := sdk.AccAddress([]byte("sender"))
+	toContract := make([]byte, 20)
+	heights := []int64{1, 1, 2, 3}
+	txParamHashes := make([][]byte, len(heights))
+	for i, height := range heights {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, fromAddr, 2, []byte{1}, toContract, "unlock", []byte{byte(i)}))
+		txParamHashes[i], _, _ = app.CcmKeeper.GetCrossChainTxBySequence(ctx, 2, uint64(i))
+	}
+	params := app.CcmKeeper.GetParams(ctx)
+	params.TxDetailRetentionBlocks = 10
+	app.CcmKeeper.SetParams(ctx, params)
+
+	// every detail is past the retention, the budget spreads the deletion over the blocks
+	ctx = ctx.WithBlockHeight(20)
+	app.CcmKeeper.PruneCrossChainTxDetails(ctx, 3)
+	require.Equal(t, int64(3), app.CcmKeeper.GetTxDetailRetainedHeight(ctx))
+	for i := range heights {
+		makeTxParam, height, found := app.CcmKeeper.GetCrossChainTx(ctx, txParamHashes[i])
+		require.True(t, found)
+		require.Equal(t, heights[i], height)
+		require.Equal(t, i == 3, makeTxParam != nil)
+	}
+
+	ctx = ctx.WithBlockHeight(21)
+	app.CcmKeeper.PruneCrossChainTxDetails(ctx, 3)
+	require.Equal(t, int64(11), app.CcmKeeper.GetTxDetailRetainedHeight(ctx))
+	makeTxParam, _, found := app.CcmKeeper.GetCrossChainTx(ctx, txParamHashes[3])
+	require.True(t, found)
+	require.Nil(t, makeTxParam)
+}
+
+func Test_ccm_MigrateTxDetailIndex(t *testing.T) {
+	app, ctx := createTestApp(true)
+	store := ctx.KVStore(app.GetKey(ccm.StoreKey))
+	legacyHash := []byte("legacy")
+	store.Set(ccm.GetCrossChainTxKey(legacyHash), []byte{1})
+
+	ctx = ctx.WithBlockHeight(5)
+	require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, sdk.AccAddress([]byte("sender")), 2, []byte{1}, make([]byte, 20), "unlock", []byte{1}))
+	txParamHash, _, _ := app.CcmKeeper.GetCrossChainTxBySequence(ctx, 2, 0)
+
+	// the legacy detail is never reached by the pruning before the migration
+	params := app.CcmKeeper.GetParams(ctx)
+	params.TxDetailRetentionBlocks = 10
+	app.CcmKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(20)
+	app.CcmKeeper.PruneCrossChainTxDetails(ctx, ccm.MaxTxDetailsPrunedPerBlock)
+	makeTxParam, _, found := app.CcmKeeper.GetCrossChainTx(ctx, legacyHash)
+	require.True(t, found)
+	require.NotNil(t, makeTxParam)
+
+	app.CcmKeeper.MigrateTxDetailIndex(ctx)
+	makeTxParam, height, found := app.CcmKeeper.GetCrossChainTx(ctx, legacyHash)
+	require.True(t, found)
+	require.NotNil(t, makeTxParam)
+	require.Equal(t, int64(0), height)
+	_, height, _ = app.CcmKeeper.GetCrossChainTx(ctx, txParamHash)
+	require.Equal(t, int64(5), height)
+
+	ctx = ctx.WithBlockHeight(21)
+	app.CcmKeeper.PruneCrossChainTxDetails(ctx, ccm.MaxTxDetailsPrunedPerBlock)
+	makeTxParam, _, found = app.CcmKeeper.GetCrossChainTx(ctx, legacyHash)
+	require.True(t, found)
+	require.Nil(t, makeTxParam)
+}
+
+func Test_ccm_DenomRegistry(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(10)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+	alice, bob := sdk.AccAddress([]byte("alice")), sdk.AccAddress([]byte("bob"))
+
+	require.Nil(t, app.CcmKeeper.RegisterDenom(ctx, alice, "btca", "btcx", 8, "BTC", 1, []byte{1}))
+	require.Nil(t, app.CcmKeeper.RegisterDenom(ctx, alice, "usdt", "lockproxy", 0, "", 0, nil))
+	require.Nil(t, app.CcmKeeper.RegisterDenom(ctx, bob, "eth", "lockproxy", 0, "", 2, []byte{2}))
+	require.Error(t, app.CcmKeeper.RegisterDenom(ctx, bob, "usdt", "ft", 0, "", 0, nil))
+	require.Error(t, app.CcmKeeper.RegisterDenom(ctx, bob, "stake", "ft", 0, "", 0, nil))
+	require.Error(t, app.CcmKeeper.RegisterDenom(ctx, bob, "x", "ft", 0, "", 0, nil))
+
+	info, found := app.CcmKeeper.GetDenomInfo(ctx, "btca")
+	require.True(t, found)
+	require.Equal(t, ccm.NewDenomInfo("btca", alice, "btcx", 8, "BTC", 1, []byte{1}, 10), info)
+	require.Equal(t, alice, app.CcmKeeper.GetDenomCreator(ctx, "btca"))
+	info, _ = app.CcmKeeper.GetDenomInfo(ctx, "usdt")
+	require.Equal(t, "usdt", info.Symbol)
+	_, exist := app.CcmKeeper.ExistDenom(ctx, "eth")
+	require.True(t, exist)
+
+	denoms := func(infos []ccm.DenomInfo) []string {
+		var ds []string
+		for _, info := range infos {
+			ds = append(ds, info.Denom)
+		}
+		return ds
+	}
+	require.Equal(t, []string{"btca", "usdt"}, denoms(app.CcmKeeper.GetDenomsByCreator(ctx, alice)))
+	require.Equal(t, []string{"eth", "usdt"}, denoms(app.CcmKeeper.GetDenomsByModule(ctx, "lockproxy")))
+	require.Empty(t, app.CcmKeeper.GetDenomsByModule(ctx, "lockproxypip1"))
+
+	require.Error(t, app.CcmKeeper.UpdateDenomMetadata(ctx, bob, "usdt", 6, "USDT"))
+	require.Error(t, app.CcmKeeper.UpdateDenomMetadata(ctx, alice, "none", 6, "USDT"))
+	require.Nil(t, app.CcmKeeper.UpdateDenomMetadata(ctx, alice, "usdt", 6, "USDT"))
+	info, _ = app.CcmKeeper.GetDenomInfo(ctx, "usdt")
+	require.Equal(t, uint32(6), info.Decimals)
+	require.Equal(t, "USDT", info.Symbol)
+	require.Equal(t, []string{"eth", "usdt"}, denoms(app.CcmKeeper.GetDenomsByModule(ctx, "lockproxy")))
+}
+
+func Test_ccm_MigrateDenomIndex(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
+	alice, bob := sdk.AccAddress([]byte("alice")), sdk.AccAddress([]byte("bob"))
+
+	// the denoms created before the registry only carry their creator, btca was bound by btcx towards chain 2
+	store := ctx.KVStore(app.GetKey(ccm.StoreKey))
+	store.Set(ccm.GetDenomToCreatorKey("btca"), alice)
+	store.Set(ccm.GetDenomToCreatorKey("oep4"), alice)
+	store.Set(ccm.GetDenomToCreatorKey("unbound"), bob)
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, alice, "btca", 2, []byte{1}))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, alice, "oep4", 7, []byte{1}))
+	require.Nil(t, k.RegisterDenom(ctx, bob, "usdt", "lockproxy", 6, "USDT", 0, nil))
+	require.Empty(t, k.GetDenomsByCreator(ctx, alice))
+
+	k.MigrateDenomIndex(ctx)
+	denoms := func(infos []ccm.DenomInfo) []string {
+		var ds []string
+		for _, info := range infos {
+			ds = append(ds, info.Denom)
+		}
+		return ds
+	}
+	require.Equal(t, []string{"btca", "oep4"}, denoms(k.GetDenomsByCreator(ctx, alice)))
+	require.Equal(t, []string{"unbound", "usdt"}, denoms(k.GetDenomsByCreator(ctx, bob)))
+	// chain 7 is not registered, so the module of oep4 is not inferred
+	require.Equal(t, []string{"btca"}, denoms(k.GetDenomsByModule(ctx, "btcx")))
+	require.Equal(t, []string{"usdt"}, denoms(k.GetDenomsByModule(ctx, "lockproxy")))
+	info, _ := k.GetDenomInfo(ctx, "btca")
+	require.Equal(t, ccm.NewDenomInfo("btca", alice, "btcx", 0, "btca", 0, nil, 0), info)
+	info, _ = k.GetDenomInfo(ctx, "usdt")
+	require.Equal(t, uint32(6), info.Decimals)
+
+	// the creator index of a denom without registry entry follows its ownership
+	require.Nil(t, k.TransferDenomOwnership(ctx, alice, "oep4", bob))
+	require.Nil(t, k.AcceptDenomOwnership(ctx, bob, "oep4"))
+	require.Equal(t, []string{"btca"}, denoms(k.GetDenomsByCreator(ctx, alice)))
+	require.Equal(t, []string{"oep4", "unbound", "usdt"}, denoms(k.GetDenomsByCreator(ctx, bob)))
+}
+
+func Test_ccm_DenomOwnership(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+	alice, bob, carol := sdk.AccAddress([]byte("alice")), sdk.AccAddress([]byte("bob")), sdk.AccAddress([]byte("carol"))
+	require.Nil(t, app.CcmKeeper.RegisterDenom(ctx, alice, "usdt", "lockproxy", 0, "", 0, nil))
+
+	require.Error(t, app.CcmKeeper.TransferDenomOwnership(ctx, bob, "usdt", bob))
+	require.Error(t, app.CcmKeeper.TransferDenomOwnership(ctx, alice, "none", bob))
+	require.Error(t, app.CcmKeeper.AcceptDenomOwnership(ctx, bob, "usdt"))
+
+	require.Nil(t, app.CcmKeeper.TransferDenomOwnership(ctx, alice, "usdt", bob))
+	require.Equal(t, bob, app.CcmKeeper.GetPendingDenomOwner(ctx, "usdt"))
+	require.Error(t, app.CcmKeeper.AcceptDenomOwnership(ctx, carol, "usdt"))
+	require.Equal(t, alice, app.CcmKeeper.GetDenomCreator(ctx, "usdt"))
+
+	// proposing the current owner cancels the pending transfer
+	require.Nil(t, app.CcmKeeper.TransferDenomOwnership(ctx, alice, "usdt", alice))
+	require.Empty(t, app.CcmKeeper.GetPendingDenomOwner(ctx, "usdt"))
+	require.Error(t, app.CcmKeeper.AcceptDenomOwnership(ctx, bob, "usdt"))
+
+	require.Nil(t, app.CcmKeeper.TransferDenomOwnership(ctx, alice, "usdt", bob))
+	ctx = ctx.WithBlockHeight(7)
+	require.Nil(t, app.CcmKeeper.AcceptDenomOwnership(ctx, bob, "usdt"))
+	require.Equal(t, bob, app.CcmKeeper.GetDenomCreator(ctx, "usdt"))
+	require.Empty(t, app.CcmKeeper.GetPendingDenomOwner(ctx, "usdt"))
+	info, _ := app.CcmKeeper.GetDenomInfo(ctx, "usdt")
+	require.Equal(t, bob, info.Creator)
+	require.Empty(t, app.CcmKeeper.GetDenomsByCreator(ctx, alice))
+	require.Len(t, app.CcmKeeper.GetDenomsByCreator(ctx, bob), 1)
+	require.Error(t, app.CcmKeeper.UpdateDenomMetadata(ctx, alice, "usdt", 6, "USDT"))
+	require.Nil(t, app.CcmKeeper.UpdateDenomMetadata(ctx, bob, "usdt", 6, "USDT"))
+
+	require.Nil(t, app.CcmKeeper.TransferDenomOwnership(ctx, bob, "usdt", carol))
+	require.Nil(t, app.CcmKeeper.AcceptDenomOwnership(ctx.WithBlockHeight(9), carol, "usdt"))
+	require.Equal(t, []ccm.DenomOwnershipTransfer{
+		{PreviousOwner: alice, NewOwner: bob, Height: 7},
+		{PreviousOwner: bob, NewOwner: carol, Height: 9},
+	}, app.CcmKeeper.GetDenomOwnershipHistory(ctx, "usdt"))
+}
+
+func makeTestProof(fromChainId, toChainId uint64, crossChainId []byte) string {
+	value := polycommon.NewZeroCopySink(nil)
+	merkleValue := &ccmc.ToMerkleValue{
+		TxHash:      []byte("txhash"),
+		FromChainID: fromChainId,
+		MakeTxParam: &ccmc.MakeTxParam{
+			TxHash:              []byte("txhash"),
+			CrossChainID:        crossChainId,
+			FromContractAddress: []byte("from"),
+			ToChainID:           toChainId,
+			ToContractAddress:   []byte("to"),
+			Method:              "unlock",
+			Args:                []byte("args"),
+		},
+	}
+	merkleValue.Serialization(value)
+	proof := polycommon.NewZeroCopySink(nil)
+	proof.WriteVarBytes(value.Bytes())
+	return hex.EncodeToString(proof.Bytes())
+}
+
+func Test_ccm_RejectDoneTxDecorator(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.CcmKeeper
+
+	require.Error(t, k.PrecheckCrossChainTx(ctx, "zz"))
+	require.Error(t, k.PrecheckCrossChainTx(ctx, ""))
+	require.Error(t, k.PrecheckCrossChainTx(ctx, makeTestProof(2, 3, []byte{1})))
+	require.Nil(t, k.PrecheckCrossChainTx(ctx, makeTestProof(2, 5, []byte{1})))
+	keeper.PutDoneTx(k, ctx, 2, []byte{1})
+	require.Error(t, k.PrecheckCrossChainTx(ctx, makeTestProof(2, 5, []byte{1})))
+
+	decorator := ccm.NewRejectDoneTxDecorator(k)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	submitter := sdk.AccAddress([]byte("relayer"))
+	newTx := func(proof string) sdk.Tx {
+		return auth.NewStdTx([]sdk.Msg{ccm.NewMsgProcessCrossChainTx(submitter, 0, proof, "", "", "")}, auth.StdFee{}, nil, "")
+	}
+
+	_, err := decorator.AnteHandle(ctx, newTx(makeTestProof(2, 5, []byte{1})), false, next)
+	require.Error(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx(makeTestProof(2, 5, []byte{2})), false, next)
+	require.Nil(t, err)
+	// the done txs are only rejected from the mempool, DeliverTx still verifies the proof itself
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), newTx(makeTestProof(2, 5, []byte{1})), false, next)
+	require.Nil(t, err)
+}
+
+func Test_ccm_RelayFeeWaiver(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.CcmKeeper
+	ctx = ctx.WithBlockHeight(13)
+
+	relayer := sdk.AccAddress([]byte("relayer"))
+	other := sdk.AccAddress([]byte("other"))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(5)))
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, feeCollector, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))))
+
+	// the relay msgs run as in DeliverTx, after the ante handler and until one of them fails
+	decorator := ccm.NewRelayFeeWaiverDecorator(k)
+	router := ccm.NewRelayFeeRefundRouter(k, baseapp.NewRouter())
+	failing := false
+	// the "epoch" headers switch the consensus peers, the "synced" ones are already synced and change nothing
+	handler := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if failing {
+			return nil, sdkerrors.ErrInvalidRequest
+		}
+		if msg, ok := msg.(headersync.MsgSyncHeadersParam); ok && msg.Headers[0] == "epoch" {
+			return &sdk.Result{Events: sdk.Events{sdk.NewEvent(headersync.EventTypeUpdateConsensusPeers)}}, nil
+		}
+		return &sdk.Result{}, nil
+	}
+	router.AddRoute(headersync.RouterKey, handler).AddRoute(ccm.RouterKey, handler)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	isRefunded := func(ctx sdk.Context, fee sdk.Coins, msgs ...sdk.Msg) bool {
+		before := app.BankKeeper.GetCoins(ctx, relayer).AmountOf("stake")
+		tx := auth.NewStdTx(msgs, auth.NewStdFee(200000, fee), []auth.StdSignature{{}}, "")
+		ctx, err := decorator.AnteHandle(ctx, tx, false, next)
+		require.Nil(t, err)
+		for _, msg := range msgs {
+			if _, err := router.Route(ctx, msg.Route())(ctx, msg); err != nil {
+				break
+			}
+		}
+		refunded := app.BankKeeper.GetCoins(ctx, relayer).AmountOf("stake").Sub(before)
+		require.True(t, refunded.IsZero() || refunded.Equal(fee.AmountOf("stake")))
+		return refunded.IsPositive()
+	}
+	syncHeaders := headersync.NewMsgSyncHeadersParam(relayer, []string{"epoch"})
+	syncSynced := headersync.NewMsgSyncHeadersParam(relayer, []string{"synced"})
+	processTx := func(crossChainId []byte) sdk.Msg {
+		return ccm.NewMsgProcessCrossChainTx(relayer, 0, makeTestProof(2, 5, crossChainId), "", "", "")
+	}
+
+	// disabled by default
+	require.False(t, isRefunded(ctx, fee, syncHeaders))
+
+	params := app.CcmKeeper.GetParams(ctx)
+	params.RelayFeeWaiverLimit = 2
+	params.RelayFeeWaiverWindow = 10
+	app.CcmKeeper.SetParams(ctx, params)
+
+	// only the txs made of relay msgs of the fee payer, none of them already done, are eligible
+	keeper.PutDoneTx(k, ctx, 2, []byte{1})
+	require.False(t, isRefunded(ctx, fee, processTx([]byte{1})))
+	require.False(t, isRefunded(ctx, fee, syncHeaders, ccm.NewMsgCreateCrossChainTx(relayer, 2, []byte("to"), "unlock", []byte("args"))))
+	require.False(t, isRefunded(ctx, fee, syncHeaders, headersync.NewMsgSyncHeadersParam(other, []string{"epoch"})))
+	// the fee is only charged, and refunded, in DeliverTx, and a zero fee is not worth counting
+	require.False(t, isRefunded(ctx.WithIsCheckTx(true), fee, syncHeaders))
+	require.False(t, isRefunded(ctx, sdk.NewCoins(), syncHeaders))
+	// a failing relay tx pays its fee
+	failing = true
+	require.False(t, isRefunded(ctx, fee, syncHeaders, processTx([]byte{2})))
+	failing = false
+	// a header sync which changes nothing pays, so does the tx it is part of
+	require.False(t, isRefunded(ctx, fee, syncSynced))
+	require.False(t, isRefunded(ctx, fee, syncSynced, processTx([]byte{4})))
+	windowStart, used := k.GetRelayFeeWaiverUsage(ctx, relayer)
+	require.Equal(t, int64(10), windowStart)
+	require.Equal(t, uint64(0), used)
+
+	require.True(t, isRefunded(ctx, fee, syncHeaders, processTx([]byte{2})))
+	require.True(t, isRefunded(ctx, fee, processTx([]byte{3})))
+	require.False(t, isRefunded(ctx, fee, syncHeaders), "the limit of the window is exhausted")
+	_, used = k.GetRelayFeeWaiverUsage(ctx, relayer)
+	require.Equal(t, uint64(2), used)
+	require.Equal(t, sdk.NewInt(90), app.BankKeeper.GetCoins(ctx, feeCollector).AmountOf("stake"))
+
+	ctx = ctx.WithBlockHeight(20)
+	require.True(t, isRefunded(ctx, fee, syncHeaders))
+	windowStart, used = k.GetRelayFeeWaiverUsage(ctx, relayer)
+	require.Equal(t, int64(20), windowStart)
+	require.Equal(t, uint64(1), used)
+}
+
+func Test_ccm_ProcessExecuteTx(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.CcmKeeper
+
+	fromContract, _ := hex.DecodeString("c330431496364497d7257839737b5e4596f5ac06")
+	remote := ccm.GetRemoteAccountAddress(2, fromContract)
+	require.NotEqual(t, remote, ccm.GetRemoteAccountAddress(3, fromContract))
+	recipient := sdk.AccAddress([]byte("recipient-address-20"))
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)))
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, remote, coins))
+
+	params := k.GetParams(ctx)
+	params.AllowedRemoteMsgTypes = []string{"bank/send"}
+	k.SetParams(ctx, params)
+
+	execute := func(toContract []byte, msgs ...sdk.Msg) (ccm.ExecuteResultArgs, error) {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := ccm.ExecuteArgs{Msgs: app.Codec().MustMarshalJSON(msgs)}
+		args.Serialization(sink)
+		merkleValue := &ccmc.ToMerkleValue{
+			TxHash:      []byte("polyhash"),
+			FromChainID: 2,
+			MakeTxParam: &ccmc.MakeTxParam{
+				TxHash:              []byte("txhash"),
+				CrossChainID:        []byte{1},
+				FromContractAddress: fromContract,
+				ToChainID:           5,
+				ToContractAddress:   toContract,
+				Method:              ccm.MethodExecute,
+				Args:                sink.Bytes(),
+			},
+		}
+		var result ccm.ExecuteResultArgs
+		sequence := k.GetCrossChainSequence(ctx, 2)
+		if err := k.ProcessExecuteTx(ctx, merkleValue); err != nil {
+			return result, err
+		}
+		// the outcome is reported back to the source contract
+		_, makeTxParamBs, found := k.GetCrossChainTxBySequence(ctx, 2, sequence)
+		require.True(t, found)
+		var makeTxParam ccmc.MakeTxParam
+		require.Nil(t, makeTxParam.Deserialization(polycommon.NewZeroCopySource(makeTxParamBs)))
+		require.Equal(t, fromContract, makeTxParam.ToContractAddress)
+		require.Equal(t, ccm.RemoteAccountContractHash, makeTxParam.FromContractAddress)
+		require.Equal(t, ccm.MethodExecuteResult, makeTxParam.Method)
+		require.Nil(t, result.Deserialization(polycommon.NewZeroCopySource(makeTxParam.Args)))
+		require.Equal(t, []byte("txhash"), result.TxHash)
+		return result, nil
+	}
+
+	_, err := execute([]byte("lockproxy"), bank.NewMsgSend(remote, recipient, coins))
+	require.Error(t, err)
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+	}{
+		{"not allowed", []sdk.Msg{ccm.NewMsgCreateCrossChainTx(remote, 2, fromContract, "unlock", []byte("args"))}},
+		{"not signed by the remote account", []sdk.Msg{bank.NewMsgSend(recipient, remote, coins)}},
+		{"failing", []sdk.Msg{bank.NewMsgSend(remote, recipient, coins.Add(coins...))}},
+		{"partly failing", []sdk.Msg{bank.NewMsgSend(remote, recipient, coins), bank.NewMsgSend(remote, recipient, coins)}},
+		{"empty", []sdk.Msg{}},
+	}
+	for _, tc := range testCases {
+		result, err := execute(ccm.RemoteAccountContractHash, tc.msgs...)
+		require.Nil(t, err, tc.name)
+		require.False(t, result.Success, tc.name)
+		require.True(t, app.BankKeeper.GetCoins(ctx, recipient).IsZero(), tc.name)
+	}
+
+	result, err := execute(ccm.RemoteAccountContractHash, bank.NewMsgSend(remote, recipient, coins))
+	require.Nil(t, err)
+	require.True(t, result.Success, string(result.Result))
+	require.Equal(t, coins, app.BankKeeper.GetCoins(ctx, recipient))
+	require.True(t, app.BankKeeper.GetCoins(ctx, remote).IsZero())
+}
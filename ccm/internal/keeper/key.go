@@ -34,6 +34,28 @@ var (
 	DenomToCreatorPrefix     = []byte{0x03}
 
 	CrossChainIdKey = []byte("crosschainid")
+	// per toChainId counterparts of CrossChainIdKey, the next sequence and the tx of every assigned sequence
+	CrossChainSequencePrefix   = []byte{0x04}
+	CrossChainSequenceTxPrefix = []byte{0x05}
+	// per fromChainId high-water mark, every numeric crossChainId below it is done
+	CrossChainDoneTxWatermarkPrefix = []byte{0x06}
+	// outgoing txs indexed by creation height for pruning their details, and by hash to still prove existence after it
+	CrossChainTxHeightPrefix = []byte{0x07}
+	CrossChainTxHashPrefix   = []byte{0x08}
+
+	TxDetailRetainedHeightKey = []byte("txdetailretainedheight")
+
+	// denom registry, with the denoms indexed by creator and by owning module
+	DenomInfoPrefix      = []byte{0x09}
+	DenomByCreatorPrefix = []byte{0x0a}
+	DenomByModulePrefix  = []byte{0x0b}
+	// two-step denom ownership transfers, the proposed owner and the completed transfers of every denom
+	DenomPendingOwnerPrefix     = []byte{0x0c}
+	DenomOwnershipHistoryPrefix = []byte{0x0d}
+	// per relayer usage of the relay fee waiver in the current window
+	RelayFeeWaiverPrefix = []byte{0x0e}
+	// per fromChainId done numeric crossChainIds, ordered by value
+	CrossChainDoneTxIdPrefix = []byte{0x0f}
 )
 
 func GetCrossChainTxKey(crossChainTxSum []byte) []byte {
@@ -45,6 +67,90 @@ func GetDoneTxKey(fromChainId uint64, crossChainid []byte) []byte {
 	return append(append(CrossChainDoneTxPrefix, b...), crossChainid...)
 }
 
+func GetCrossChainTxHeightPrefix(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(CrossChainTxHeightPrefix, b...)
+}
+
+func GetCrossChainTxHeightKey(height int64, txParamHash []byte) []byte {
+	return append(GetCrossChainTxHeightPrefix(height), txParamHash...)
+}
+
+func GetCrossChainTxHashKey(txParamHash []byte) []byte {
+	return append(CrossChainTxHashPrefix, txParamHash...)
+}
+
+func GetDoneTxChainPrefix(fromChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, fromChainId)
+	return append(CrossChainDoneTxPrefix, b...)
+}
+
+func GetDoneTxIdChainPrefix(fromChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, fromChainId)
+	return append(CrossChainDoneTxIdPrefix, b...)
+}
+
+func GetDoneTxIdKey(fromChainId uint64, crossChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, crossChainId)
+	return append(GetDoneTxIdChainPrefix(fromChainId), b...)
+}
+
+func GetDoneTxWatermarkKey(fromChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, fromChainId)
+	return append(CrossChainDoneTxWatermarkPrefix, b...)
+}
+
+func GetCrossChainSequenceKey(toChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, toChainId)
+	return append(CrossChainSequencePrefix, b...)
+}
+
+func GetCrossChainSequenceTxKey(toChainId, sequence uint64) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], toChainId)
+	binary.BigEndian.PutUint64(b[8:], sequence)
+	return append(CrossChainSequenceTxPrefix, b...)
+}
+
 func GetDenomToCreatorKey(denom string) []byte {
 	return append(DenomToCreatorPrefix, []byte(denom)...)
 }
+
+func GetDenomInfoKey(denom string) []byte {
+	return append(DenomInfoPrefix, []byte(denom)...)
+}
+
+// GetDenomByCreatorPrefix length prefixes creator so that no creator is a prefix of another one
+func GetDenomByCreatorPrefix(creator []byte) []byte {
+	return append(append(DenomByCreatorPrefix, byte(len(creator))), creator...)
+}
+
+func GetDenomByCreatorKey(creator []byte, denom string) []byte {
+	return append(GetDenomByCreatorPrefix(creator), []byte(denom)...)
+}
+
+func GetDenomByModulePrefix(module string) []byte {
+	return append(append(DenomByModulePrefix, byte(len(module))), []byte(module)...)
+}
+
+func GetDenomByModuleKey(module string, denom string) []byte {
+	return append(GetDenomByModulePrefix(module), []byte(denom)...)
+}
+
+func GetDenomPendingOwnerKey(denom string) []byte {
+	return append(DenomPendingOwnerPrefix, []byte(denom)...)
+}
+
+func GetDenomOwnershipHistoryKey(denom string) []byte {
+	return append(DenomOwnershipHistoryPrefix, []byte(denom)...)
+}
+
+func GetRelayFeeWaiverKey(relayer []byte) []byte {
+	return append(RelayFeeWaiverPrefix, relayer...)
+}
@@ -18,6 +18,9 @@
 package keeper
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -36,6 +39,30 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryParams(ctx, k)
 		case types.QueryModuleBalance:
 			return queryModuleBalance(ctx, req, k)
+		case types.QueryChainRegistry:
+			return queryChainRegistry(ctx, k)
+		case types.QueryChainInfo:
+			return queryChainInfo(ctx, req, k)
+		case types.QueryCrossChainSequence:
+			return queryCrossChainSequence(ctx, req, k)
+		case types.QueryCrossChainTxBySequence:
+			return queryCrossChainTxBySequence(ctx, req, k)
+		case types.QueryCrossChainTx:
+			return queryCrossChainTx(ctx, req, k)
+		case types.QueryTxDetailRetainedHeight:
+			return queryTxDetailRetainedHeight(ctx, k)
+		case types.QueryDenomInfo:
+			return queryDenomInfo(ctx, req, k)
+		case types.QueryDenomsByCreator:
+			return queryDenomsByCreator(ctx, req, k)
+		case types.QueryDenomsByModule:
+			return queryDenomsByModule(ctx, req, k)
+		case types.QueryDenomOwnership:
+			return queryDenomOwnership(ctx, req, k)
+		case types.QueryRelayFeeWaiver:
+			return queryRelayFeeWaiver(ctx, req, k)
+		case types.QueryRemoteAccount:
+			return queryRemoteAccount(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -87,3 +114,219 @@ func queryModuleBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 
 	return bz, nil
 }
+
+func queryChainRegistry(ctx sdk.Context, k Keeper) ([]byte, error) {
+	chains := k.GetChainRegistry(ctx)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, chains)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal chain registry: %+v to JSON", chains)
+	}
+
+	return bz, nil
+}
+
+func queryChainInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryChainInfoParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	chain, ok := k.GetChainInfo(ctx, params.ChainId)
+	if !ok {
+		return nil, types.ErrChainRegistry(fmt.Sprintf("chainId: %d is not registered", params.ChainId))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, chain)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal chain info: %+v to JSON", chain)
+	}
+
+	return bz, nil
+}
+
+func queryCrossChainSequence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCrossChainSequenceParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	res := types.QueryCrossChainSequenceRes{ToChainId: params.ToChainId, NextSequence: k.GetCrossChainSequence(ctx, params.ToChainId)}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal cross chain sequence: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryCrossChainTxBySequence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCrossChainTxBySequenceParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	txParamHash, makeTxParam, found := k.GetCrossChainTxBySequence(ctx, params.ToChainId, params.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no cross chain tx towards chainId: %d with sequence: %d", params.ToChainId, params.Sequence)
+	}
+	res := types.QueryCrossChainTxBySequenceRes{ToChainId: params.ToChainId, Sequence: params.Sequence, TxParamHash: txParamHash, MakeTxParam: makeTxParam}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal cross chain tx: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryCrossChainTx(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCrossChainTxParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	makeTxParam, height, found := k.GetCrossChainTx(ctx, params.TxParamHash)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no cross chain tx with make tx param hash: %x", params.TxParamHash)
+	}
+	res := types.QueryCrossChainTxRes{TxParamHash: params.TxParamHash, Height: height, MakeTxParam: makeTxParam, Pruned: makeTxParam == nil}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal cross chain tx: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryTxDetailRetainedHeight(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res := types.QueryTxDetailRetainedHeightRes{RetainedHeight: k.GetTxDetailRetainedHeight(ctx), RetentionBlocks: k.GetTxDetailRetentionBlocks(ctx)}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal retained height: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryDenomInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomInfoParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	info, found := k.GetDenomInfo(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrDenomRegistry(fmt.Sprintf("denom: %s is not registered", params.Denom))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal denom info: %+v to JSON", info)
+	}
+
+	return bz, nil
+}
+
+func queryDenomsByCreator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomsByCreatorParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	infos := k.GetDenomsByCreator(ctx, params.Creator)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, infos)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal denom infos: %+v to JSON", infos)
+	}
+
+	return bz, nil
+}
+
+func queryDenomsByModule(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomsByModuleParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	infos := k.GetDenomsByModule(ctx, params.Module)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, infos)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal denom infos: %+v to JSON", infos)
+	}
+
+	return bz, nil
+}
+
+func queryDenomOwnership(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomOwnershipParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	owner := k.GetDenomCreator(ctx, params.Denom)
+	if len(owner) == 0 {
+		return nil, types.ErrDenomRegistry(fmt.Sprintf("denom: %s is not registered", params.Denom))
+	}
+	res := types.QueryDenomOwnershipRes{
+		Denom:        params.Denom,
+		Owner:        owner,
+		PendingOwner: k.GetPendingDenomOwner(ctx, params.Denom),
+		History:      k.GetDenomOwnershipHistory(ctx, params.Denom),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal denom ownership: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryRelayFeeWaiver(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRelayFeeWaiverParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	windowStart, used := k.GetRelayFeeWaiverUsage(ctx, params.Relayer)
+	res := types.QueryRelayFeeWaiverRes{
+		Relayer:     params.Relayer,
+		Limit:       k.GetRelayFeeWaiverLimit(ctx),
+		Window:      k.GetRelayFeeWaiverWindow(ctx),
+		WindowStart: windowStart,
+		Used:        used,
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal relay fee waiver: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryRemoteAccount(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRemoteAccountParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	res := types.QueryRemoteAccountRes{
+		FromChainId:     params.FromChainId,
+		FromContract:    hex.EncodeToString(params.FromContract),
+		Address:         types.GetRemoteAccountAddress(params.FromChainId, params.FromContract),
+		AllowedMsgTypes: k.GetAllowedRemoteMsgTypes(ctx),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal remote account: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
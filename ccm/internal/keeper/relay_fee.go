@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+)
+
+// GetRelayFeeWaiverLimit returns how many relay txs of a relayer may have their fee refunded per window, 0 disables the waiver
+func (k Keeper) GetRelayFeeWaiverLimit(ctx sdk.Context) uint64 {
+	var limit uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyRelayFeeWaiverLimit, &limit)
+	return limit
+}
+
+// GetRelayFeeWaiverWindow returns the length in blocks of the windows the relay fee waiver limit applies to
+func (k Keeper) GetRelayFeeWaiverWindow(ctx sdk.Context) uint64 {
+	window := types.DefaultRelayFeeWaiverWindow
+	k.paramSpace.GetIfExists(ctx, types.KeyRelayFeeWaiverWindow, &window)
+	return window
+}
+
+func (k Keeper) relayFeeWaiverWindowStart(ctx sdk.Context) int64 {
+	window := int64(k.GetRelayFeeWaiverWindow(ctx))
+	return ctx.BlockHeight() - ctx.BlockHeight()%window
+}
+
+// GetRelayFeeWaiverUsage returns the start height of the current window and how many relay txs of relayer
+// had their fee refunded since then
+func (k Keeper) GetRelayFeeWaiverUsage(ctx sdk.Context, relayer sdk.AccAddress) (windowStart int64, used uint64) {
+	windowStart = k.relayFeeWaiverWindowStart(ctx)
+	bz := ctx.KVStore(k.storeKey).Get(GetRelayFeeWaiverKey(relayer))
+	if bz == nil || int64(binary.BigEndian.Uint64(bz[:8])) != windowStart {
+		return windowStart, 0
+	}
+	return windowStart, binary.BigEndian.Uint64(bz[8:])
+}
+
+// UseRelayFeeWaiver counts one more refunded relay tx for relayer, it returns false without counting it
+// when the waiver is disabled or relayer has exhausted its limit for the current window
+func (k Keeper) UseRelayFeeWaiver(ctx sdk.Context, relayer sdk.AccAddress) bool {
+	limit := k.GetRelayFeeWaiverLimit(ctx)
+	windowStart, used := k.GetRelayFeeWaiverUsage(ctx, relayer)
+	if used >= limit {
+		return false
+	}
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(windowStart))
+	binary.BigEndian.PutUint64(bz[8:], used+1)
+	ctx.KVStore(k.storeKey).Set(GetRelayFeeWaiverKey(relayer), bz)
+	return true
+}
+
+// RefundRelayFee pays fee back to relayer from the fee collector, once its relay tx has succeeded, unless relayer
+// has exhausted its RelayFeeWaiverLimit for the current window. It returns whether fee was refunded
+func (k Keeper) RefundRelayFee(ctx sdk.Context, relayer sdk.AccAddress, fee sdk.Coins) (bool, error) {
+	if fee.IsZero() || !k.UseRelayFeeWaiver(ctx, relayer) {
+		return false, nil
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, auth.FeeCollectorName, relayer, fee); err != nil {
+		return false, types.ErrRelayFeeRefund(fmt.Sprintf("refund fee: %s to relayer: %s, Error: %s", fee.String(), relayer.String(), err.Error()))
+	}
+	return true, nil
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+)
+
+// MountRouter sets the router the msgs of the remote accounts are run through
+func (k *Keeper) MountRouter(router sdk.Router) {
+	k.router = router
+}
+
+// GetAllowedRemoteMsgTypes returns the "route/type" of the msgs remote accounts may run
+func (k Keeper) GetAllowedRemoteMsgTypes(ctx sdk.Context) []string {
+	var msgTypes []string
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowedRemoteMsgTypes, &msgTypes)
+	return msgTypes
+}
+
+// IsRemoteMsgAllowed checks the governance managed allowlist of the msgs remote accounts may run
+func (k Keeper) IsRemoteMsgAllowed(ctx sdk.Context, msg sdk.Msg) bool {
+	msgType := types.RemoteMsgType(msg)
+	for _, allowed := range k.GetAllowedRemoteMsgTypes(ctx) {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessExecuteTx runs the msgs of a MethodExecute cross chain tx with the remote account of its source contract
+// as signer, and reports the outcome back to that contract. The msgs run all or nothing, their failure is reported
+// back and does not fail the processing of the tx, so that it is not relayed again
+func (k Keeper) ProcessExecuteTx(ctx sdk.Context, merkleValue *ccmc.ToMerkleValue) error {
+	param := merkleValue.MakeTxParam
+	if !bytes.Equal(param.ToContractAddress, types.RemoteAccountContractHash) {
+		return types.ErrProcessCrossChainTx(fmt.Sprintf("method: %s is not supported by toContractAddr: %x", types.MethodExecute, param.ToContractAddress))
+	}
+	remoteAccount := types.GetRemoteAccountAddress(merkleValue.FromChainID, param.FromContractAddress)
+
+	result := types.ExecuteResultArgs{TxHash: param.TxHash, Success: true}
+	data, err := k.executeRemoteMsgs(ctx, remoteAccount, param.Args)
+	if err != nil {
+		result.Success, result.Result = false, []byte(err.Error())
+		k.Logger(ctx).Info(fmt.Sprintf("remote account: %s failed to execute msgs, Error: %s", remoteAccount.String(), err.Error()))
+	} else {
+		result.Result = data
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeExecuteRemoteMsgs,
+		sdk.NewAttribute(types.AttributeKeyFromChainId, strconv.FormatUint(merkleValue.FromChainID, 10)),
+		sdk.NewAttribute(types.AttributeKeyFromContract, hex.EncodeToString(param.FromContractAddress)),
+		sdk.NewAttribute(types.AttributeKeyRemoteAccount, remoteAccount.String()),
+		sdk.NewAttribute(types.AttributeKeyTxHash, hex.EncodeToString(param.TxHash)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(result.Success)),
+	)
+	if err != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	sink := polycommon.NewZeroCopySink(nil)
+	result.Serialization(sink)
+	return k.CreateCrossChainTx(ctx, remoteAccount, merkleValue.FromChainID, types.RemoteAccountContractHash, param.FromContractAddress, types.MethodExecuteResult, sink.Bytes())
+}
+
+// executeRemoteMsgs runs the msgs encoded in argsBs with remoteAccount as their only signer, their writes and
+// events are only kept if all of them succeed. The data returned by the msgs is concatenated
+func (k Keeper) executeRemoteMsgs(ctx sdk.Context, remoteAccount sdk.AccAddress, argsBs []byte) ([]byte, error) {
+	if k.router == nil {
+		return nil, types.ErrRemoteAccount("no router is mounted")
+	}
+	var args types.ExecuteArgs
+	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs)); err != nil {
+		return nil, types.ErrRemoteAccount(err.Error())
+	}
+	var msgs []sdk.Msg
+	if err := k.cdc.UnmarshalJSON(args.Msgs, &msgs); err != nil {
+		return nil, types.ErrRemoteAccount(fmt.Sprintf("decode msgs, Error: %s", err.Error()))
+	}
+	if len(msgs) == 0 {
+		return nil, types.ErrRemoteAccount("no msg to execute")
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	var data []byte
+	var events sdk.Events
+	for i, msg := range msgs {
+		if !k.IsRemoteMsgAllowed(ctx, msg) {
+			return nil, types.ErrRemoteAccount(fmt.Sprintf("msg %d of type: %s is not allowed", i, types.RemoteMsgType(msg)))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, types.ErrRemoteAccount(fmt.Sprintf("msg %d is invalid, Error: %s", i, err.Error()))
+		}
+		if signers := msg.GetSigners(); len(signers) != 1 || !signers[0].Equals(remoteAccount) {
+			return nil, types.ErrRemoteAccount(fmt.Sprintf("msg %d must be signed by the remote account: %s only", i, remoteAccount.String()))
+		}
+		handler := k.router.Route(cacheCtx, msg.Route())
+		if handler == nil {
+			return nil, types.ErrRemoteAccount(fmt.Sprintf("msg %d has unrecognized route: %s", i, msg.Route()))
+		}
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return nil, types.ErrRemoteAccount(fmt.Sprintf("msg %d failed, Error: %s", i, err.Error()))
+		}
+		data = append(data, res.Data...)
+		events = append(events, res.Events...)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+	return data, nil
+}
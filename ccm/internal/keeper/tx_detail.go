@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+)
+
+// GetTxDetailRetentionBlocks returns for how many blocks the MakeTxParam of outgoing txs is kept, 0 means forever
+func (k Keeper) GetTxDetailRetentionBlocks(ctx sdk.Context) uint64 {
+	var blocks uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyTxDetailRetentionBlocks, &blocks)
+	return blocks
+}
+
+// indexCrossChainTx records the creation height of an outgoing tx, both for pruning its detail by height
+// and for proving its existence by hash once the detail is gone
+func (k Keeper) indexCrossChainTx(ctx sdk.Context, txParamHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(ctx.BlockHeight()))
+	store.Set(GetCrossChainTxHashKey(txParamHash), height)
+	store.Set(GetCrossChainTxHeightKey(ctx.BlockHeight(), txParamHash), []byte{})
+}
+
+// GetCrossChainTx looks up the outgoing tx txParamHash, makeTxParam is nil once its detail has been pruned,
+// height is 0 for the txs created before the txs were indexed by height
+func (k Keeper) GetCrossChainTx(ctx sdk.Context, txParamHash []byte) (makeTxParam []byte, height int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	makeTxParam = store.Get(GetCrossChainTxKey(txParamHash))
+	if heightBs := store.Get(GetCrossChainTxHashKey(txParamHash)); heightBs != nil {
+		return makeTxParam, int64(binary.BigEndian.Uint64(heightBs)), true
+	}
+	return makeTxParam, 0, makeTxParam != nil
+}
+
+// GetTxDetailRetainedHeight returns the oldest height from which the details of outgoing txs are retained
+func (k Keeper) GetTxDetailRetainedHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(TxDetailRetainedHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) setTxDetailRetainedHeight(ctx sdk.Context, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(k.storeKey).Set(TxDetailRetainedHeightKey, bz)
+}
+
+// PruneCrossChainTxDetails deletes the MakeTxParam of the outgoing txs created more than TxDetailRetentionBlocks
+// blocks ago, their hash index is kept. At most maxPruned details are deleted, the rest is left to the next blocks
+func (k Keeper) PruneCrossChainTxDetails(ctx sdk.Context, maxPruned int) {
+	retention := k.GetTxDetailRetentionBlocks(ctx)
+	if retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+	retainedHeight := ctx.BlockHeight() - int64(retention)
+	if retainedHeight <= k.GetTxDetailRetainedHeight(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(CrossChainTxHeightPrefix, GetCrossChainTxHeightPrefix(retainedHeight))
+	var heightKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		if len(heightKeys) == maxPruned {
+			// out of budget, everything below the first height key left is pruned
+			retainedHeight = int64(binary.BigEndian.Uint64(iter.Key()[len(CrossChainTxHeightPrefix):]))
+			break
+		}
+		heightKeys = append(heightKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range heightKeys {
+		txParamHash := key[len(CrossChainTxHeightPrefix)+8:]
+		store.Delete(GetCrossChainTxKey(txParamHash))
+		store.Delete(key)
+	}
+	k.setTxDetailRetainedHeight(ctx, retainedHeight)
+	if len(heightKeys) != 0 {
+		k.Logger(ctx).Info(fmt.Sprintf("pruned %d cross chain tx details below height: %d", len(heightKeys), retainedHeight))
+	}
+}
+
+// MigrateTxDetailIndex indexes the details of the outgoing txs created before the txs were indexed by height
+// at height 0, so that they are pruned first once a retention is set
+func (k Keeper) MigrateTxDetailIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, CrossChainTxDetailPrefix)
+	var txParamHashes [][]byte
+	for ; iter.Valid(); iter.Next() {
+		txParamHash := iter.Key()[len(CrossChainTxDetailPrefix):]
+		if !store.Has(GetCrossChainTxHashKey(txParamHash)) {
+			txParamHashes = append(txParamHashes, txParamHash)
+		}
+	}
+	iter.Close()
+
+	for _, txParamHash := range txParamHashes {
+		store.Set(GetCrossChainTxHashKey(txParamHash), make([]byte, 8))
+		store.Set(GetCrossChainTxHeightKey(0, txParamHash), []byte{})
+	}
+}
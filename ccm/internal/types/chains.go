@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+// Virtual machine families of the chains registered in the chain registry
+const (
+	VMFamilyEVM    = "evm"
+	VMFamilyNeoVM  = "neovm"
+	VMFamilyWasmVM = "wasmvm"
+	VMFamilyUTXO   = "utxo"
+	VMFamilyCosmos = "cosmos"
+	VMFamilyOther  = "other"
+)
+
+// Encodings of the destination address bytes carried in cross chain txs
+const (
+	AddressEncodingRaw    = "raw"    // arbitrary bytes, e.g. 20 bytes of an evm address
+	AddressEncodingBase58 = "base58" // ascii bytes of a base58 address string, e.g. btc address
+	AddressEncodingBech32 = "bech32" // ascii bytes of a bech32 address string
+)
+
+// MaxAmountIntWidth is the widest integer, in bytes, the bridge modules serialize amounts into
+const MaxAmountIntWidth = 32
+
+// ChainInfo describes a destination chain registered in the chain registry
+type ChainInfo struct {
+	ChainId         uint64 `json:"chain_id" yaml:"chain_id"`                 // chain id in poly chain network
+	Name            string `json:"name" yaml:"name"`                         // human readable name
+	VMFamily        string `json:"vm_family" yaml:"vm_family"`               // one of evm, neovm, wasmvm, utxo, cosmos and other
+	AddressLength   uint32 `json:"address_length" yaml:"address_length"`     // byte length of destination addresses, 0 for variable length
+	AddressEncoding string `json:"address_encoding" yaml:"address_encoding"` // one of raw, base58 and bech32
+	AmountIntWidth  uint32 `json:"amount_int_width" yaml:"amount_int_width"` // byte width of amount integers on the chain, e.g. 32 for uint256
+	Enabled         bool   `json:"enabled" yaml:"enabled"`                   // cross chain txs towards disabled chains are rejected
+}
+
+func NewChainInfo(chainId uint64, name, vmFamily string, addressLength uint32, addressEncoding string, amountIntWidth uint32, enabled bool) ChainInfo {
+	return ChainInfo{
+		ChainId:         chainId,
+		Name:            name,
+		VMFamily:        vmFamily,
+		AddressLength:   addressLength,
+		AddressEncoding: addressEncoding,
+		AmountIntWidth:  amountIntWidth,
+		Enabled:         enabled,
+	}
+}
+
+func (c ChainInfo) Validate() error {
+	if c.ChainId <= 0 {
+		return fmt.Errorf("chain: %s has invalid chainId: %d", c.Name, c.ChainId)
+	}
+	if c.Name == "" {
+		return fmt.Errorf("chain: %d has empty name", c.ChainId)
+	}
+	switch c.VMFamily {
+	case VMFamilyEVM, VMFamilyNeoVM, VMFamilyWasmVM, VMFamilyUTXO, VMFamilyCosmos, VMFamilyOther:
+	default:
+		return fmt.Errorf("chain: %d has unknown vm family: %s", c.ChainId, c.VMFamily)
+	}
+	switch c.AddressEncoding {
+	case AddressEncodingRaw, AddressEncodingBase58, AddressEncodingBech32:
+	default:
+		return fmt.Errorf("chain: %d has unknown address encoding: %s", c.ChainId, c.AddressEncoding)
+	}
+	if c.AmountIntWidth == 0 || c.AmountIntWidth > MaxAmountIntWidth {
+		return fmt.Errorf("chain: %d has invalid amount integer width: %d, should be within [1, %d]", c.ChainId, c.AmountIntWidth, MaxAmountIntWidth)
+	}
+	return nil
+}
+
+// ValidateAddress checks the destination address bytes against the address format of the chain
+func (c ChainInfo) ValidateAddress(addr []byte) error {
+	if len(addr) == 0 {
+		return fmt.Errorf("empty address for chain: %d", c.ChainId)
+	}
+	if c.AddressLength != 0 && uint32(len(addr)) != c.AddressLength {
+		return fmt.Errorf("address: %x has length %d, chain: %d expects %d", addr, len(addr), c.ChainId, c.AddressLength)
+	}
+	switch c.AddressEncoding {
+	case AddressEncodingBase58:
+		if len(base58.Decode(string(addr))) == 0 {
+			return fmt.Errorf("address: %s is not valid base58 for chain: %d", string(addr), c.ChainId)
+		}
+	case AddressEncodingBech32:
+		if _, _, err := bech32.DecodeAndConvert(string(addr)); err != nil {
+			return fmt.Errorf("address: %s is not valid bech32 for chain: %d, Error: %s", string(addr), c.ChainId, err.Error())
+		}
+	}
+	return nil
+}
+
+// ValidateAmount checks the amount fits into the amount integer of the chain
+func (c ChainInfo) ValidateAmount(amount *big.Int) error {
+	if amount.Sign() < 0 {
+		return fmt.Errorf("amount: %s is negative", amount.String())
+	}
+	if amount.BitLen() > int(c.AmountIntWidth)*8 {
+		return fmt.Errorf("amount: %s overflows the %d bytes integer of chain: %d", amount.String(), c.AmountIntWidth, c.ChainId)
+	}
+	return nil
+}
+
+func (c ChainInfo) String() string {
+	return fmt.Sprintf(`
+  ChainId:				%d
+  Name:					%s
+  VMFamily:				%s
+  AddressLength:		%d
+  AddressEncoding:		%s
+  AmountIntWidth:		%d
+  Enabled:				%t
+`, c.ChainId, c.Name, c.VMFamily, c.AddressLength, c.AddressEncoding, c.AmountIntWidth, c.Enabled)
+}
@@ -27,6 +27,10 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(MsgProcessCrossChainTx{}, ModuleName+"/MsgProcessCrossChainTx", nil)
+	cdc.RegisterConcrete(MsgCreateCrossChainTx{}, ModuleName+"/MsgCreateCrossChainTx", nil)
+	cdc.RegisterConcrete(MsgUpdateDenomMetadata{}, ModuleName+"/MsgUpdateDenomMetadata", nil)
+	cdc.RegisterConcrete(MsgTransferDenomOwnership{}, ModuleName+"/MsgTransferDenomOwnership", nil)
+	cdc.RegisterConcrete(MsgAcceptDenomOwnership{}, ModuleName+"/MsgAcceptDenomOwnership", nil)
 }
 
 func init() {
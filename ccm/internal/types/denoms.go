@@ -0,0 +1,93 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomInfo describes a denom created by one of the bridge modules, as recorded in the denom registry
+type DenomInfo struct {
+	Denom           string         `json:"denom" yaml:"denom"`
+	Creator         sdk.AccAddress `json:"creator" yaml:"creator"`                     // account controlling the asset bindings of the denom
+	Module          string         `json:"module" yaml:"module"`                       // bridge module which created the denom, e.g. btcx, ft, lockproxy
+	Decimals        uint32         `json:"decimals" yaml:"decimals"`                   // display decimals, 0 if never set
+	Symbol          string         `json:"symbol" yaml:"symbol"`                       // display symbol, the denom itself unless set
+	OriginChainId   uint64         `json:"origin_chain_id" yaml:"origin_chain_id"`     // chain id in poly chain network the asset is native to, 0 if native to this chain
+	OriginAssetHash []byte         `json:"origin_asset_hash" yaml:"origin_asset_hash"` // asset hash on the origin chain, empty if native to this chain
+	CreationHeight  int64          `json:"creation_height" yaml:"creation_height"`     // 0 for the denoms created before the registry
+}
+
+func NewDenomInfo(denom string, creator sdk.AccAddress, module string, decimals uint32, symbol string, originChainId uint64, originAssetHash []byte, creationHeight int64) DenomInfo {
+	return DenomInfo{
+		Denom:           denom,
+		Creator:         creator,
+		Module:          module,
+		Decimals:        decimals,
+		Symbol:          symbol,
+		OriginChainId:   originChainId,
+		OriginAssetHash: originAssetHash,
+		CreationHeight:  creationHeight,
+	}
+}
+
+func (d DenomInfo) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if d.Creator.Empty() {
+		return fmt.Errorf("denom: %s has empty creator", d.Denom)
+	}
+	if d.Module == "" {
+		return fmt.Errorf("denom: %s has empty module", d.Denom)
+	}
+	if d.Symbol == "" {
+		return fmt.Errorf("denom: %s has empty symbol", d.Denom)
+	}
+	return nil
+}
+
+func (d DenomInfo) String() string {
+	return fmt.Sprintf(`
+  Denom:				%s
+  Creator:				%s
+  Module:				%s
+  Decimals:				%d
+  Symbol:				%s
+  OriginChainId:		%d
+  OriginAssetHash:		%x
+  CreationHeight:		%d
+`, d.Denom, d.Creator.String(), d.Module, d.Decimals, d.Symbol, d.OriginChainId, d.OriginAssetHash, d.CreationHeight)
+}
+
+// DenomOwnershipTransfer records a completed change of the creator of a denom
+type DenomOwnershipTransfer struct {
+	PreviousOwner sdk.AccAddress `json:"previous_owner" yaml:"previous_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	Height        int64          `json:"height" yaml:"height"`
+}
+
+func (t DenomOwnershipTransfer) String() string {
+	return fmt.Sprintf(`
+  PreviousOwner:		%s
+  NewOwner:				%s
+  Height:				%d
+`, t.PreviousOwner.String(), t.NewOwner.String(), t.Height)
+}
@@ -34,6 +34,13 @@ var (
 	ErrMsgProcessCrossChainTxType = sdkerrors.Register(ModuleName, 5, "ErrMsgProcessCrossChainTxType")
 	ErrMsgCreateCrossChainTxType  = sdkerrors.Register(ModuleName, 6, "ErrMsgCreateCrossChainTxType")
 	ErrGetModuleBalanceType       = sdkerrors.Register(ModuleName, 7, "ErrGetModuleBalanceType")
+	ErrCreateCrossChainTxType     = sdkerrors.Register(ModuleName, 8, "ErrCreateCrossChainTxType")
+	ErrChainRegistryType          = sdkerrors.Register(ModuleName, 9, "ErrChainRegistryType")
+	ErrDenomRegistryType          = sdkerrors.Register(ModuleName, 10, "ErrDenomRegistryType")
+	ErrMsgUpdateDenomMetadataType = sdkerrors.Register(ModuleName, 11, "ErrMsgUpdateDenomMetadataType")
+	ErrMsgDenomOwnershipType      = sdkerrors.Register(ModuleName, 12, "ErrMsgDenomOwnershipType")
+	ErrRemoteAccountType          = sdkerrors.Register(ModuleName, 13, "ErrRemoteAccountType")
+	ErrRelayFeeRefundType         = sdkerrors.Register(ModuleName, 14, "ErrRelayFeeRefundType")
 )
 
 func ErrMarshalSpecificTypeFail(o interface{}, err error) error {
@@ -62,3 +69,31 @@ func ErrMsgCreateCrossChainTx(reason string) error {
 func ErrGetModuleBalance(reason string) error {
 	return sdkerrors.Wrapf(ErrGetModuleBalanceType, "Reason: %s", reason)
 }
+
+func ErrCreateCrossChainTx(reason string) error {
+	return sdkerrors.Wrapf(ErrCreateCrossChainTxType, "Reason: %s", reason)
+}
+
+func ErrChainRegistry(reason string) error {
+	return sdkerrors.Wrapf(ErrChainRegistryType, "Reason: %s", reason)
+}
+
+func ErrDenomRegistry(reason string) error {
+	return sdkerrors.Wrapf(ErrDenomRegistryType, "Reason: %s", reason)
+}
+
+func ErrMsgUpdateDenomMetadata(reason string) error {
+	return sdkerrors.Wrapf(ErrMsgUpdateDenomMetadataType, "Reason: %s", reason)
+}
+
+func ErrMsgDenomOwnership(reason string) error {
+	return sdkerrors.Wrapf(ErrMsgDenomOwnershipType, "Reason: %s", reason)
+}
+
+func ErrRemoteAccount(reason string) error {
+	return sdkerrors.Wrapf(ErrRemoteAccountType, "Reason: %s", reason)
+}
+
+func ErrRelayFeeRefund(reason string) error {
+	return sdkerrors.Wrapf(ErrRelayFeeRefundType, "Reason: %s", reason)
+}
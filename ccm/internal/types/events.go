@@ -27,6 +27,7 @@ const (
 	AttributeKeyFromContract    = "from_contract"
 	AttributeKeyToChainId       = "to_chain_id"
 	AttributeCrossChainId       = "cross_chainId"
+	AttributeKeySequence        = "sequence"
 	AttributeKeyTxParamHash     = "make_tx_param_hash"
 	AttributeKeyMakeTxParam     = "make_tx_param"
 
@@ -35,4 +36,25 @@ const (
 	AttributeKeyMerkleValueMakeTxParamTxHash            = "merkle_value:make_tx_param:txhash"
 	AttributeKeyMerkleValueMakeTxParamToContractAddress = "merkle_value:make_tx_param:to_contract_address"
 	AttributeKeyFromChainId                             = "from_chain_id"
+
+	EventTypeRegisterDenom       = "register_denom"
+	EventTypeUpdateDenomMetadata = "update_denom_metadata"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyCreator          = "creator"
+	AttributeKeyModule           = "module"
+	AttributeKeyDecimals         = "decimals"
+	AttributeKeySymbol           = "symbol"
+	AttributeKeyOriginChainId    = "origin_chain_id"
+	AttributeKeyOriginAssetHash  = "origin_asset_hash"
+
+	EventTypeTransferDenomOwnership = "transfer_denom_ownership"
+	EventTypeAcceptDenomOwnership   = "accept_denom_ownership"
+	AttributeKeyNewOwner            = "new_owner"
+	AttributeKeyPreviousOwner       = "previous_owner"
+
+	EventTypeExecuteRemoteMsgs = "execute_remote_msgs"
+	AttributeKeyRemoteAccount  = "remote_account"
+	AttributeKeyTxHash         = "tx_hash"
+	AttributeKeySuccess        = "success"
+	AttributeKeyError          = "error"
 )
@@ -53,3 +53,7 @@ type UnlockKeeper interface {
 type AssetKeeper interface {
 	RegisterAsset(ctx sdk.Context, fromChainId uint64, fromContractAddr []byte, toContractAddr []byte, argsBs []byte) error
 }
+
+type DataKeeper interface {
+	StoreData(ctx sdk.Context, fromChainId uint64, fromContractAddr []byte, toContractAddr []byte, argsBs []byte, polyHeight uint32) error
+}
@@ -37,8 +37,35 @@ const (
 	// RouterKey is the message route for gov
 	RouterKey = ModuleName
 
+	// ConsensusVersion is the version of the store layout, the stores of older versions are migrated on upgrade
+	ConsensusVersion = 5
+
+	// MaxTxDetailsPrunedPerBlock bounds the outgoing tx details deleted by the end blocker of one block
+	MaxTxDetailsPrunedPerBlock = 1000
+
 	// Query endpoints supported by the minting querier
 	QueryParameters = "parameters"
 
 	QueryIfContainContract = "if_contain_contract"
+
+	QueryChainRegistry = "chain_registry"
+	QueryChainInfo     = "chain_info"
+
+	QueryCrossChainSequence     = "cross_chain_sequence"
+	QueryCrossChainTxBySequence = "cross_chain_tx_by_sequence"
+
+	QueryCrossChainTx           = "cross_chain_tx"
+	QueryTxDetailRetainedHeight = "tx_detail_retained_height"
+
+	QueryDenomInfo       = "denom_info"
+	QueryDenomsByCreator = "denoms_by_creator"
+	QueryDenomsByModule  = "denoms_by_module"
+	QueryDenomOwnership  = "denom_ownership"
+
+	QueryRelayFeeWaiver = "relay_fee_waiver"
+	QueryRemoteAccount  = "remote_account"
 )
+
+// MethodStoreData is the cross chain method handed to the mounted data keeper, it must match the one the data
+// relay application sends and receives
+const MethodStoreData = "storeData"
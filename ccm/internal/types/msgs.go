@@ -22,12 +22,16 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // Governance message types and routes
 const (
-	TypeMsgProcessCrossChainTx = "process_cross_chain_tx"
-	TypeMsgCreateCoins         = "create_coins"
+	TypeMsgProcessCrossChainTx    = "process_cross_chain_tx"
+	TypeMsgCreateCrossChainTx     = "create_cross_chain_tx"
+	TypeMsgUpdateDenomMetadata    = "update_denom_metadata"
+	TypeMsgTransferDenomOwnership = "transfer_denom_ownership"
+	TypeMsgAcceptDenomOwnership   = "accept_denom_ownership"
 )
 
 type MsgProcessCrossChainTx struct {
@@ -43,7 +47,7 @@ func NewMsgProcessCrossChainTx(submitter sdk.AccAddress, fromChainId uint64, pro
 	return MsgProcessCrossChainTx{submitter, fromChainId, proof, header, headerProof, curHeader}
 }
 
-//nolint
+// nolint
 func (msg MsgProcessCrossChainTx) Route() string { return RouterKey }
 func (msg MsgProcessCrossChainTx) Type() string  { return TypeMsgProcessCrossChainTx }
 
@@ -84,22 +88,34 @@ func (msg MsgProcessCrossChainTx) GetSigners() []sdk.AccAddress {
 }
 
 type MsgCreateCrossChainTx struct {
+	Sender            sdk.AccAddress // the account invoking the cross chain call, FromContractAddress is derived from it
 	ToChainID         uint64
 	ToContractAddress []byte
 	Method            string
 	Args              []byte
 }
 
-func NewMsgCreateCrossChainTx(toChainId uint64, toContractAddr []byte, method string, args []byte) MsgCreateCrossChainTx {
-	return MsgCreateCrossChainTx{ToChainID: toChainId, ToContractAddress: toContractAddr, Method: method, Args: args}
+// GetSenderContractHash returns the FromContractAddress of the cross chain txs created by sender through
+// MsgCreateCrossChainTx, which is what the destination contracts see the allowlisted calls coming from. It is
+// hashed under its own domain, like the lock proxy, publisher and remote account hashes, so that no account can
+// pose as another contract hash of this chain
+func GetSenderContractHash(sender sdk.AccAddress) []byte {
+	return tmhash.SumTruncated(append([]byte(ModuleName+"/sender/"), sender.Bytes()...))
 }
 
-//nolint
+func NewMsgCreateCrossChainTx(sender sdk.AccAddress, toChainId uint64, toContractAddr []byte, method string, args []byte) MsgCreateCrossChainTx {
+	return MsgCreateCrossChainTx{Sender: sender, ToChainID: toChainId, ToContractAddress: toContractAddr, Method: method, Args: args}
+}
+
+// nolint
 func (msg MsgCreateCrossChainTx) Route() string { return RouterKey }
-func (msg MsgCreateCrossChainTx) Type() string  { return TypeMsgCreateCoins }
+func (msg MsgCreateCrossChainTx) Type() string  { return TypeMsgCreateCrossChainTx }
 
 // Implements Msg.
 func (msg MsgCreateCrossChainTx) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgCreateCrossChainTx.Sender is empty")
+	}
 	if msg.ToChainID <= 0 {
 		return ErrMsgCreateCrossChainTx(fmt.Sprintf("invalid chainId: %d", msg.ToChainID))
 	}
@@ -117,12 +133,13 @@ func (msg MsgCreateCrossChainTx) ValidateBasic() error {
 }
 
 func (msg MsgCreateCrossChainTx) String() string {
-	return fmt.Sprintf(`Create Coins Message:
+	return fmt.Sprintf(`Create Cross Chain Tx Message:
+  Sender:            		%s
   ToChainID:         		%d
   ToContractAddress: 		%x
   Method: 					%s
   Args:						%x
-`, msg.ToChainID, msg.ToContractAddress, msg.Method, msg.Args)
+`, msg.Sender.String(), msg.ToChainID, msg.ToContractAddress, msg.Method, msg.Args)
 }
 
 // Implements Msg.
@@ -133,5 +150,147 @@ func (msg MsgCreateCrossChainTx) GetSignBytes() []byte {
 
 // Implements Msg.
 func (msg MsgCreateCrossChainTx) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{}
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgUpdateDenomMetadata sets the display metadata of a registered denom, only its creator may send it
+type MsgUpdateDenomMetadata struct {
+	Creator  sdk.AccAddress
+	Denom    string
+	Decimals uint32
+	Symbol   string
+}
+
+func NewMsgUpdateDenomMetadata(creator sdk.AccAddress, denom string, decimals uint32, symbol string) MsgUpdateDenomMetadata {
+	return MsgUpdateDenomMetadata{Creator: creator, Denom: denom, Decimals: decimals, Symbol: symbol}
+}
+
+//nolint
+func (msg MsgUpdateDenomMetadata) Route() string { return RouterKey }
+func (msg MsgUpdateDenomMetadata) Type() string  { return TypeMsgUpdateDenomMetadata }
+
+// Implements Msg.
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgUpdateDenomMetadata.Creator is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrMsgUpdateDenomMetadata(fmt.Sprintf("invalid denom: %s, Error: %s", msg.Denom, err.Error()))
+	}
+	if msg.Symbol == "" {
+		return ErrMsgUpdateDenomMetadata("Symbol is empty")
+	}
+	return nil
+}
+
+func (msg MsgUpdateDenomMetadata) String() string {
+	return fmt.Sprintf(`Update Denom Metadata Message:
+  Creator:         		%s
+  Denom:         		%s
+  Decimals:         	%d
+  Symbol:         		%s
+`, msg.Creator.String(), msg.Denom, msg.Decimals, msg.Symbol)
+}
+
+// Implements Msg.
+func (msg MsgUpdateDenomMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUpdateDenomMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgTransferDenomOwnership proposes NewOwner as the creator of Denom, the transfer completes once NewOwner
+// accepts it through MsgAcceptDenomOwnership. Proposing Owner itself cancels the pending transfer
+type MsgTransferDenomOwnership struct {
+	Owner    sdk.AccAddress
+	Denom    string
+	NewOwner sdk.AccAddress
+}
+
+func NewMsgTransferDenomOwnership(owner sdk.AccAddress, denom string, newOwner sdk.AccAddress) MsgTransferDenomOwnership {
+	return MsgTransferDenomOwnership{Owner: owner, Denom: denom, NewOwner: newOwner}
+}
+
+//nolint
+func (msg MsgTransferDenomOwnership) Route() string { return RouterKey }
+func (msg MsgTransferDenomOwnership) Type() string  { return TypeMsgTransferDenomOwnership }
+
+// Implements Msg.
+func (msg MsgTransferDenomOwnership) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgTransferDenomOwnership.Owner is empty")
+	}
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgTransferDenomOwnership.NewOwner is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrMsgDenomOwnership(fmt.Sprintf("invalid denom: %s, Error: %s", msg.Denom, err.Error()))
+	}
+	return nil
+}
+
+func (msg MsgTransferDenomOwnership) String() string {
+	return fmt.Sprintf(`Transfer Denom Ownership Message:
+  Owner:         		%s
+  Denom:         		%s
+  NewOwner:         	%s
+`, msg.Owner.String(), msg.Denom, msg.NewOwner.String())
+}
+
+// Implements Msg.
+func (msg MsgTransferDenomOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgTransferDenomOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgAcceptDenomOwnership completes the pending ownership transfer of Denom towards NewOwner
+type MsgAcceptDenomOwnership struct {
+	NewOwner sdk.AccAddress
+	Denom    string
+}
+
+func NewMsgAcceptDenomOwnership(newOwner sdk.AccAddress, denom string) MsgAcceptDenomOwnership {
+	return MsgAcceptDenomOwnership{NewOwner: newOwner, Denom: denom}
+}
+
+//nolint
+func (msg MsgAcceptDenomOwnership) Route() string { return RouterKey }
+func (msg MsgAcceptDenomOwnership) Type() string  { return TypeMsgAcceptDenomOwnership }
+
+// Implements Msg.
+func (msg MsgAcceptDenomOwnership) ValidateBasic() error {
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgAcceptDenomOwnership.NewOwner is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrMsgDenomOwnership(fmt.Sprintf("invalid denom: %s, Error: %s", msg.Denom, err.Error()))
+	}
+	return nil
+}
+
+func (msg MsgAcceptDenomOwnership) String() string {
+	return fmt.Sprintf(`Accept Denom Ownership Message:
+  NewOwner:         	%s
+  Denom:         		%s
+`, msg.NewOwner.String(), msg.Denom)
+}
+
+// Implements Msg.
+func (msg MsgAcceptDenomOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgAcceptDenomOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewOwner}
 }
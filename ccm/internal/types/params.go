@@ -18,19 +18,63 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Parameter store keys
 var (
 	KeyCurrentChainIdForPolyChain = []byte("ChainIdForPolyChain")
+	KeyAllowedCrossChainCalls     = []byte("AllowedCrossChainCalls")
+	KeyChainRegistry              = []byte("ChainRegistry")
+	KeyTxDetailRetentionBlocks    = []byte("TxDetailRetentionBlocks")
+	KeyRelayFeeWaiverLimit        = []byte("RelayFeeWaiverLimit")
+	KeyRelayFeeWaiverWindow       = []byte("RelayFeeWaiverWindow")
+	KeyAllowedRemoteMsgTypes      = []byte("AllowedRemoteMsgTypes")
+	KeyDoneTxWindow               = []byte("DoneTxWindow")
 )
 
 type Params struct {
-	ChainIdInPolyNet uint64 `json:"chain_id_in_poly_net" yaml:"chain_id_in_poly_net"` // chain id of current cosmos chain for cross chain in poly chain network
+	ChainIdInPolyNet        uint64                  `json:"chain_id_in_poly_net" yaml:"chain_id_in_poly_net"`             // chain id of current cosmos chain for cross chain in poly chain network
+	AllowedCrossChainCalls  []AllowedCrossChainCall `json:"allowed_cross_chain_calls" yaml:"allowed_cross_chain_calls"`   // destinations accounts are allowed to call through MsgCreateCrossChainTx
+	ChainRegistry           []ChainInfo             `json:"chain_registry" yaml:"chain_registry"`                         // destination chains cross chain txs can be created towards, txs towards unregistered chains are rejected
+	TxDetailRetentionBlocks uint64                  `json:"tx_detail_retention_blocks" yaml:"tx_detail_retention_blocks"` // blocks the MakeTxParam of outgoing txs is kept for, 0 keeps it forever
+	RelayFeeWaiverLimit     uint64                  `json:"relay_fee_waiver_limit" yaml:"relay_fee_waiver_limit"`         // relay txs of a relayer whose fee is refunded per window, 0 disables the waiver
+	RelayFeeWaiverWindow    uint64                  `json:"relay_fee_waiver_window" yaml:"relay_fee_waiver_window"`       // length in blocks of the windows RelayFeeWaiverLimit applies to
+	AllowedRemoteMsgTypes   []string                `json:"allowed_remote_msg_types" yaml:"allowed_remote_msg_types"`     // "route/type" of the msgs remote accounts may run
+	DoneTxWindow            uint64                  `json:"done_tx_window" yaml:"done_tx_window"`                         // ids below the highest done crossChainId of a chain the done ones are kept for, 0 keeps them all
+}
+
+// AllowedCrossChainCall is one (toChainId, toContract, method) entry of the allowlist
+// consulted by MsgCreateCrossChainTx, ToContract is the hex format of the target contract hash
+type AllowedCrossChainCall struct {
+	ToChainId  uint64 `json:"to_chain_id" yaml:"to_chain_id"`
+	ToContract string `json:"to_contract" yaml:"to_contract"`
+	Method     string `json:"method" yaml:"method"`
+}
+
+func NewAllowedCrossChainCall(toChainId uint64, toContract []byte, method string) AllowedCrossChainCall {
+	return AllowedCrossChainCall{ToChainId: toChainId, ToContract: hex.EncodeToString(toContract), Method: method}
+}
+
+// Matches returns true if the entry allows calling method of toContract on toChainId
+func (c AllowedCrossChainCall) Matches(toChainId uint64, toContract []byte, method string) bool {
+	return c.ToChainId == toChainId && strings.EqualFold(c.ToContract, hex.EncodeToString(toContract)) && c.Method == method
+}
+
+func (c AllowedCrossChainCall) String() string {
+	return fmt.Sprintf("%d/%s/%s", c.ToChainId, c.ToContract, c.Method)
 }
 
+// DefaultRelayFeeWaiverWindow is about an hour of 5 seconds blocks
+const DefaultRelayFeeWaiverWindow uint64 = 720
+
+// DefaultDoneTxWindow bounds the done crossChainIds kept per source chain to a million
+const DefaultDoneTxWindow uint64 = 1000000
+
 // ParamTable for ccm module.
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -39,7 +83,14 @@ func ParamKeyTable() params.KeyTable {
 // default ccm module parameters
 func DefaultParams() Params {
 	return Params{
-		ChainIdInPolyNet: 0,
+		ChainIdInPolyNet:        0,
+		AllowedCrossChainCalls:  []AllowedCrossChainCall{},
+		ChainRegistry:           []ChainInfo{},
+		TxDetailRetentionBlocks: 0,
+		RelayFeeWaiverLimit:     0,
+		RelayFeeWaiverWindow:    DefaultRelayFeeWaiverWindow,
+		AllowedRemoteMsgTypes:   []string{},
+		DoneTxWindow:            DefaultDoneTxWindow,
 	}
 }
 
@@ -48,6 +99,27 @@ func (p Params) Validate() error {
 	if err := validateChainId(p.ChainIdInPolyNet); err != nil {
 		return err
 	}
+	if err := validateAllowedCrossChainCalls(p.AllowedCrossChainCalls); err != nil {
+		return err
+	}
+	if err := validateChainRegistry(p.ChainRegistry); err != nil {
+		return err
+	}
+	if err := validateTxDetailRetentionBlocks(p.TxDetailRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validateRelayFeeWaiverLimit(p.RelayFeeWaiverLimit); err != nil {
+		return err
+	}
+	if err := validateRelayFeeWaiverWindow(p.RelayFeeWaiverWindow); err != nil {
+		return err
+	}
+	if err := validateAllowedRemoteMsgTypes(p.AllowedRemoteMsgTypes); err != nil {
+		return err
+	}
+	if err := validateDoneTxWindow(p.DoneTxWindow); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -62,11 +134,122 @@ func validateChainId(i interface{}) error {
 	return nil
 }
 
+func validateAllowedCrossChainCalls(i interface{}) error {
+	v, ok := i.([]AllowedCrossChainCall)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, call := range v {
+		if call.ToChainId <= 0 {
+			return fmt.Errorf("allowed cross chain call: %s has invalid toChainId", call.String())
+		}
+		toContract, err := hex.DecodeString(call.ToContract)
+		if err != nil || len(toContract) == 0 {
+			return fmt.Errorf("allowed cross chain call: %s has invalid hex toContract", call.String())
+		}
+		if call.Method == "" {
+			return fmt.Errorf("allowed cross chain call: %s has empty method", call.String())
+		}
+		key := strings.ToLower(call.String())
+		if seen[key] {
+			return fmt.Errorf("allowed cross chain call: %s is duplicated", call.String())
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func validateChainRegistry(i interface{}) error {
+	v, ok := i.([]ChainInfo)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[uint64]bool, len(v))
+	for _, chain := range v {
+		if err := chain.Validate(); err != nil {
+			return err
+		}
+		if seen[chain.ChainId] {
+			return fmt.Errorf("chain: %d is registered more than once", chain.ChainId)
+		}
+		seen[chain.ChainId] = true
+	}
+	return nil
+}
+
+func validateTxDetailRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRelayFeeWaiverLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRelayFeeWaiverWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("relay fee waiver window should be positive")
+	}
+	return nil
+}
+
+func validateAllowedRemoteMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, msgType := range v {
+		parts := strings.Split(msgType, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("allowed remote msg type: %s is not of the form route/type", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("allowed remote msg type: %s is duplicated", msgType)
+		}
+		seen[msgType] = true
+	}
+	return nil
+}
+
+func validateDoneTxWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func (p Params) String() string {
+	calls := make([]string, 0, len(p.AllowedCrossChainCalls))
+	for _, call := range p.AllowedCrossChainCalls {
+		calls = append(calls, call.String())
+	}
+	chains := make([]string, 0, len(p.ChainRegistry))
+	for _, chain := range p.ChainRegistry {
+		chains = append(chains, fmt.Sprintf("%d(%s)", chain.ChainId, chain.Name))
+	}
 	return fmt.Sprintf(`Ccm Params:
   Current CrossChainId:             %d
+  Allowed CrossChainCalls:          %s
+  Registered Chains:                %s
+  TxDetail Retention Blocks:        %d
+  RelayFeeWaiver Limit:             %d
+  RelayFeeWaiver Window:            %d
+  Allowed RemoteMsgTypes:           %s
+  DoneTx Window:                    %d
 `,
-		p.ChainIdInPolyNet,
+		p.ChainIdInPolyNet, strings.Join(calls, ", "), strings.Join(chains, ", "), p.TxDetailRetentionBlocks,
+		p.RelayFeeWaiverLimit, p.RelayFeeWaiverWindow, strings.Join(p.AllowedRemoteMsgTypes, ", "), p.DoneTxWindow,
 	)
 }
 
@@ -74,5 +257,12 @@ func (p Params) String() string {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyCurrentChainIdForPolyChain, &p.ChainIdInPolyNet, validateChainId),
+		params.NewParamSetPair(KeyAllowedCrossChainCalls, &p.AllowedCrossChainCalls, validateAllowedCrossChainCalls),
+		params.NewParamSetPair(KeyChainRegistry, &p.ChainRegistry, validateChainRegistry),
+		params.NewParamSetPair(KeyTxDetailRetentionBlocks, &p.TxDetailRetentionBlocks, validateTxDetailRetentionBlocks),
+		params.NewParamSetPair(KeyRelayFeeWaiverLimit, &p.RelayFeeWaiverLimit, validateRelayFeeWaiverLimit),
+		params.NewParamSetPair(KeyRelayFeeWaiverWindow, &p.RelayFeeWaiverWindow, validateRelayFeeWaiverWindow),
+		params.NewParamSetPair(KeyAllowedRemoteMsgTypes, &p.AllowedRemoteMsgTypes, validateAllowedRemoteMsgTypes),
+		params.NewParamSetPair(KeyDoneTxWindow, &p.DoneTxWindow, validateDoneTxWindow),
 	}
 }
@@ -19,6 +19,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -64,3 +66,199 @@ type QueryModuleBalanceParam struct {
 func NewQueryModuleBalanceParam(moduleName string) QueryModuleBalanceParam {
 	return QueryModuleBalanceParam{ModuleName: moduleName}
 }
+
+type QueryChainInfoParam struct {
+	ChainId uint64
+}
+
+func NewQueryChainInfoParam(chainId uint64) QueryChainInfoParam {
+	return QueryChainInfoParam{ChainId: chainId}
+}
+
+type QueryCrossChainSequenceParam struct {
+	ToChainId uint64
+}
+
+func NewQueryCrossChainSequenceParam(toChainId uint64) QueryCrossChainSequenceParam {
+	return QueryCrossChainSequenceParam{ToChainId: toChainId}
+}
+
+// QueryCrossChainSequenceRes carries the sequence the next cross chain tx towards ToChainId will be assigned
+type QueryCrossChainSequenceRes struct {
+	ToChainId    uint64
+	NextSequence uint64
+}
+
+func (this QueryCrossChainSequenceRes) String() string {
+	return fmt.Sprintf(`
+  ToChainId:			%d,
+  NextSequence:			%d,
+`, this.ToChainId, this.NextSequence)
+}
+
+type QueryCrossChainTxBySequenceParam struct {
+	ToChainId uint64
+	Sequence  uint64
+}
+
+func NewQueryCrossChainTxBySequenceParam(toChainId, sequence uint64) QueryCrossChainTxBySequenceParam {
+	return QueryCrossChainTxBySequenceParam{ToChainId: toChainId, Sequence: sequence}
+}
+
+type QueryCrossChainTxBySequenceRes struct {
+	ToChainId   uint64
+	Sequence    uint64
+	TxParamHash []byte
+	MakeTxParam []byte
+}
+
+func (this QueryCrossChainTxBySequenceRes) String() string {
+	return fmt.Sprintf(`
+  ToChainId:			%d,
+  Sequence:				%d,
+  TxParamHash:			%x,
+  MakeTxParam:			%x,
+`, this.ToChainId, this.Sequence, this.TxParamHash, this.MakeTxParam)
+}
+
+type QueryCrossChainTxParam struct {
+	TxParamHash []byte
+}
+
+func NewQueryCrossChainTxParam(txParamHash []byte) QueryCrossChainTxParam {
+	return QueryCrossChainTxParam{TxParamHash: txParamHash}
+}
+
+// QueryCrossChainTxRes reports an outgoing tx, MakeTxParam is empty and Pruned is true once its detail has been pruned
+type QueryCrossChainTxRes struct {
+	TxParamHash []byte
+	Height      int64
+	MakeTxParam []byte
+	Pruned      bool
+}
+
+func (this QueryCrossChainTxRes) String() string {
+	return fmt.Sprintf(`
+  TxParamHash:			%x,
+  Height:				%d,
+  MakeTxParam:			%x,
+  Pruned:				%t,
+`, this.TxParamHash, this.Height, this.MakeTxParam, this.Pruned)
+}
+
+// QueryTxDetailRetainedHeightRes carries the oldest height from which the details of outgoing txs are retained
+type QueryTxDetailRetainedHeightRes struct {
+	RetainedHeight  int64
+	RetentionBlocks uint64
+}
+
+func (this QueryTxDetailRetainedHeightRes) String() string {
+	return fmt.Sprintf(`
+  RetainedHeight:		%d,
+  RetentionBlocks:		%d,
+`, this.RetainedHeight, this.RetentionBlocks)
+}
+
+type QueryDenomInfoParam struct {
+	Denom string
+}
+
+func NewQueryDenomInfoParam(denom string) QueryDenomInfoParam {
+	return QueryDenomInfoParam{Denom: denom}
+}
+
+type QueryDenomsByCreatorParam struct {
+	Creator sdk.AccAddress
+}
+
+func NewQueryDenomsByCreatorParam(creator sdk.AccAddress) QueryDenomsByCreatorParam {
+	return QueryDenomsByCreatorParam{Creator: creator}
+}
+
+type QueryDenomsByModuleParam struct {
+	Module string
+}
+
+func NewQueryDenomsByModuleParam(module string) QueryDenomsByModuleParam {
+	return QueryDenomsByModuleParam{Module: module}
+}
+
+type QueryDenomOwnershipParam struct {
+	Denom string
+}
+
+func NewQueryDenomOwnershipParam(denom string) QueryDenomOwnershipParam {
+	return QueryDenomOwnershipParam{Denom: denom}
+}
+
+// QueryDenomOwnershipRes carries the current creator of a denom, the owner it is being transferred to if any,
+// and the past ownership transfers, oldest first
+type QueryDenomOwnershipRes struct {
+	Denom        string
+	Owner        sdk.AccAddress
+	PendingOwner sdk.AccAddress
+	History      []DenomOwnershipTransfer
+}
+
+type QueryRelayFeeWaiverParam struct {
+	Relayer sdk.AccAddress
+}
+
+func NewQueryRelayFeeWaiverParam(relayer sdk.AccAddress) QueryRelayFeeWaiverParam {
+	return QueryRelayFeeWaiverParam{Relayer: relayer}
+}
+
+// QueryRelayFeeWaiverRes carries how many relay txs of a relayer had their fee refunded in the current window
+// out of the Limit allowed per window of Window blocks
+type QueryRelayFeeWaiverRes struct {
+	Relayer     sdk.AccAddress
+	Limit       uint64
+	Window      uint64
+	WindowStart int64
+	Used        uint64
+}
+
+func (this QueryRelayFeeWaiverRes) String() string {
+	return fmt.Sprintf(`
+  Relayer:				%s,
+  Limit:				%d,
+  Window:				%d,
+  WindowStart:			%d,
+  Used:					%d,
+`, this.Relayer.String(), this.Limit, this.Window, this.WindowStart, this.Used)
+}
+
+func (this QueryDenomOwnershipRes) String() string {
+	return fmt.Sprintf(`
+  Denom:				%s,
+  Owner:				%s,
+  PendingOwner:			%s,
+  History:				%v,
+`, this.Denom, this.Owner.String(), this.PendingOwner.String(), this.History)
+}
+
+type QueryRemoteAccountParam struct {
+	FromChainId  uint64
+	FromContract []byte
+}
+
+func NewQueryRemoteAccountParam(fromChainId uint64, fromContract []byte) QueryRemoteAccountParam {
+	return QueryRemoteAccountParam{FromChainId: fromChainId, FromContract: fromContract}
+}
+
+// QueryRemoteAccountRes carries the account controlled by a contract of another chain and the msg types it may run
+type QueryRemoteAccountRes struct {
+	FromChainId     uint64
+	FromContract    string
+	Address         sdk.AccAddress
+	AllowedMsgTypes []string
+}
+
+func (this QueryRemoteAccountRes) String() string {
+	return fmt.Sprintf(`
+  FromChainId:			%d,
+  FromContract:			%s,
+  Address:				%s,
+  AllowedMsgTypes:		%v,
+`, this.FromChainId, this.FromContract, this.Address.String(), this.AllowedMsgTypes)
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	polycommon "github.com/polynetwork/poly/common"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// Cross chain methods of the remote account application: a contract of another chain calls MethodExecute on
+// RemoteAccountContractHash to run msgs as its remote account, the outcome is sent back to it with MethodExecuteResult
+const (
+	MethodExecute       = "execute"
+	MethodExecuteResult = "executeResult"
+)
+
+// RemoteAccountContractHash is the contract hash the cross chain txs towards the remote account application target
+var RemoteAccountContractHash = tmhash.SumTruncated([]byte(ModuleName + "/remote_account"))
+
+// GetRemoteAccountAddress returns the account controlled by the contract fromContract of the chain fromChainId
+func GetRemoteAccountAddress(fromChainId uint64, fromContract []byte) sdk.AccAddress {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, fromChainId)
+	return tmhash.SumTruncated(append(append([]byte(ModuleName+"/remote_account/"), b...), fromContract...))
+}
+
+// ExecuteArgs are the args of MethodExecute, Msgs is the amino JSON encoding of the []sdk.Msg to run
+type ExecuteArgs struct {
+	Msgs []byte
+}
+
+func (this *ExecuteArgs) Serialization(sink *polycommon.ZeroCopySink) {
+	sink.WriteVarBytes(this.Msgs)
+}
+
+func (this *ExecuteArgs) Deserialization(source *polycommon.ZeroCopySource) error {
+	msgs, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("ExecuteArgs deserialize Msgs error")
+	}
+	this.Msgs = msgs
+	return nil
+}
+
+// ExecuteResultArgs are the args of MethodExecuteResult, TxHash is the hash of the source chain tx which called
+// MethodExecute, Result carries the data returned by the msgs on success, or the error otherwise
+type ExecuteResultArgs struct {
+	TxHash  []byte
+	Success bool
+	Result  []byte
+}
+
+func (this *ExecuteResultArgs) Serialization(sink *polycommon.ZeroCopySink) {
+	sink.WriteVarBytes(this.TxHash)
+	sink.WriteBool(this.Success)
+	sink.WriteVarBytes(this.Result)
+}
+
+func (this *ExecuteResultArgs) Deserialization(source *polycommon.ZeroCopySource) error {
+	txHash, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("ExecuteResultArgs deserialize TxHash error")
+	}
+	success, eof := source.NextBool()
+	if eof {
+		return fmt.Errorf("ExecuteResultArgs deserialize Success error")
+	}
+	result, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("ExecuteResultArgs deserialize Result error")
+	}
+	this.TxHash = txHash
+	this.Success = success
+	this.Result = result
+	return nil
+}
+
+// RemoteMsgType is the "route/type" identifier of msg matched against the AllowedRemoteMsgTypes allowlist
+func RemoteMsgType(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ccm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common/migrations"
+)
+
+// RegisterMigrations declares the store consensus version of the module and the migrations up to it
+func RegisterMigrations(registry *migrations.Registry, storeKey sdk.StoreKey, k Keeper) {
+	registry.RegisterModule(ModuleName, storeKey, ConsensusVersion)
+	// fold the one key per tx replay protection entries into per fromChainId high-water marks
+	registry.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		k.MigrateDoneTxStore(ctx)
+		return nil
+	})
+	// store the default value of the params added since, reading the param set panics on a missing key
+	registry.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		k.MigrateParams(ctx)
+		return nil
+	})
+	// index the tx details stored before the txs were indexed by height, else they are never pruned
+	registry.RegisterMigration(ModuleName, 3, func(ctx sdk.Context) error {
+		k.MigrateTxDetailIndex(ctx)
+		return nil
+	})
+	// index the denoms created before the denom registry by creator and, where it can be inferred, by module
+	registry.RegisterMigration(ModuleName, 4, func(ctx sdk.Context) error {
+		k.MigrateDenomIndex(ctx)
+		return nil
+	})
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package migrations keeps the consensus version of the store layout of every bridge module and the migrations
+// between consecutive versions. The stored version of a module lives in the module's own store under VersionKey,
+// a missing version stands for version 1, the layout the module had before it was versioned. Chains started from
+// genesis record the current versions through InitVersions, running chains catch up with RunMigrations from the
+// handler of an upgrade plan.
+package migrations
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// UpgradeName is the name of the upgrade plans whose handler runs the pending migrations of every registered module
+const UpgradeName = "bridge-store-migrations"
+
+// VersionKey is the key of the consensus version in every registered module store, it collides with no key nor
+// key prefix of the bridge modules
+var VersionKey = []byte("consensusversion")
+
+// Migration rewrites the store of a module from one consensus version to the next one
+type Migration func(ctx sdk.Context) error
+
+type module struct {
+	name       string
+	storeKey   sdk.StoreKey
+	version    uint64
+	migrations map[uint64]Migration // keyed by the version migrated from
+}
+
+// Registry holds the registered modules, migrations run in registration order of the modules
+type Registry struct {
+	modules []*module
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// RegisterModule declares the current consensus version of the store of module name
+func (r *Registry) RegisterModule(name string, storeKey sdk.StoreKey, consensusVersion uint64) {
+	if consensusVersion == 0 {
+		panic(fmt.Sprintf("module: %s has consensus version 0, versions start from 1", name))
+	}
+	if r.getModule(name) != nil {
+		panic(fmt.Sprintf("module: %s is already registered", name))
+	}
+	r.modules = append(r.modules, &module{name: name, storeKey: storeKey, version: consensusVersion, migrations: make(map[uint64]Migration)})
+}
+
+// RegisterMigration declares the migration of module name from fromVersion to fromVersion+1
+func (r *Registry) RegisterMigration(name string, fromVersion uint64, migration Migration) {
+	m := r.getModule(name)
+	if m == nil {
+		panic(fmt.Sprintf("module: %s is not registered", name))
+	}
+	if fromVersion == 0 || fromVersion >= m.version {
+		panic(fmt.Sprintf("module: %s has consensus version %d, cannot migrate from version %d", name, m.version, fromVersion))
+	}
+	if _, ok := m.migrations[fromVersion]; ok {
+		panic(fmt.Sprintf("module: %s already has a migration from version %d", name, fromVersion))
+	}
+	m.migrations[fromVersion] = migration
+}
+
+// Validate checks every module can be migrated from version 1 up to its consensus version
+func (r *Registry) Validate() error {
+	for _, m := range r.modules {
+		for v := uint64(1); v < m.version; v++ {
+			if _, ok := m.migrations[v]; !ok {
+				return fmt.Errorf("module: %s has no migration from version %d to %d", m.name, v, v+1)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Registry) getModule(name string) *module {
+	for _, m := range r.modules {
+		if m.name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// ConsensusVersion returns the declared consensus version of module name, 0 if it is not registered
+func (r *Registry) ConsensusVersion(name string) uint64 {
+	if m := r.getModule(name); m != nil {
+		return m.version
+	}
+	return 0
+}
+
+// GetStoredVersion returns the consensus version the store of module name is in
+func (r *Registry) GetStoredVersion(ctx sdk.Context, name string) uint64 {
+	m := r.getModule(name)
+	if m == nil {
+		return 0
+	}
+	return getVersion(ctx, m.storeKey)
+}
+
+func getVersion(ctx sdk.Context, storeKey sdk.StoreKey) uint64 {
+	bz := ctx.KVStore(storeKey).Get(VersionKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func setVersion(ctx sdk.Context, storeKey sdk.StoreKey, version uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	ctx.KVStore(storeKey).Set(VersionKey, bz)
+}
+
+// InitVersions records the consensus version of every module, for a chain whose stores are built by the
+// current code, i.e. at genesis
+func (r *Registry) InitVersions(ctx sdk.Context) {
+	for _, m := range r.modules {
+		setVersion(ctx, m.storeKey, m.version)
+	}
+}
+
+// RunMigrations brings the store of every module from its stored version to its consensus version, a store
+// newer than the code is an error
+func (r *Registry) RunMigrations(ctx sdk.Context) error {
+	for _, m := range r.modules {
+		stored := getVersion(ctx, m.storeKey)
+		if stored > m.version {
+			return fmt.Errorf("module: %s store has version %d, newer than the consensus version %d", m.name, stored, m.version)
+		}
+		for v := stored; v < m.version; v++ {
+			migration, ok := m.migrations[v]
+			if !ok {
+				return fmt.Errorf("module: %s has no migration from version %d to %d", m.name, v, v+1)
+			}
+			if err := migration(ctx); err != nil {
+				return fmt.Errorf("module: %s migration from version %d to %d, Error: %s", m.name, v, v+1, err.Error())
+			}
+			setVersion(ctx, m.storeKey, v+1)
+			ctx.Logger().Info(fmt.Sprintf("migrated module: %s store to version %d", m.name, v+1))
+		}
+	}
+	return nil
+}
+
+// UpgradeHandler runs the pending migrations as the handler of an upgrade plan, the chain halts if any of them fails
+func (r *Registry) UpgradeHandler() upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := r.RunMigrations(ctx); err != nil {
+			panic(fmt.Sprintf("upgrade: %s, Error: %s", plan.Name, err.Error()))
+		}
+	}
+}
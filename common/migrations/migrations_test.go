@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// fixtureContext mounts an in memory store for every key
+func fixtureContext(t *testing.T, keys ...sdk.StoreKey) sdk.Context {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	require.Nil(t, ms.LoadLatestVersion())
+	return sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+}
+
+func Test_RunMigrations(t *testing.T) {
+	keyA, keyB := sdk.NewKVStoreKey("a"), sdk.NewKVStoreKey("b")
+	ctx := fixtureContext(t, keyA, keyB)
+
+	var ran []string
+	migration := func(name string, err error) Migration {
+		return func(ctx sdk.Context) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+	r := NewRegistry()
+	r.RegisterModule("a", keyA, 3)
+	r.RegisterModule("b", keyB, 2)
+	r.RegisterMigration("a", 2, migration("a2", nil))
+	require.Error(t, r.Validate())
+	r.RegisterMigration("a", 1, migration("a1", nil))
+	r.RegisterMigration("b", 1, migration("b1", nil))
+	require.Nil(t, r.Validate())
+	require.Panics(t, func() { r.RegisterMigration("a", 3, migration("a3", nil)) })
+	require.Panics(t, func() { r.RegisterMigration("c", 1, migration("c1", nil)) })
+	require.Panics(t, func() { r.RegisterModule("b", keyB, 3) })
+
+	// unversioned stores are at version 1
+	require.Equal(t, uint64(1), r.GetStoredVersion(ctx, "a"))
+	ctx.KVStore(keyB).Set(VersionKey, []byte{0, 0, 0, 0, 0, 0, 0, 2})
+
+	require.Nil(t, r.RunMigrations(ctx))
+	require.Equal(t, []string{"a1", "a2"}, ran)
+	require.Equal(t, uint64(3), r.GetStoredVersion(ctx, "a"))
+	require.Equal(t, uint64(2), r.GetStoredVersion(ctx, "b"))
+
+	// migrations run once
+	require.Nil(t, r.RunMigrations(ctx))
+	require.Equal(t, []string{"a1", "a2"}, ran)
+}
+
+func Test_RunMigrationsFailure(t *testing.T) {
+	keyA := sdk.NewKVStoreKey("a")
+	ctx := fixtureContext(t, keyA)
+
+	r := NewRegistry()
+	r.RegisterModule("a", keyA, 3)
+	r.RegisterMigration("a", 1, func(ctx sdk.Context) error { return nil })
+	r.RegisterMigration("a", 2, func(ctx sdk.Context) error { return fmt.Errorf("corrupted store") })
+
+	require.Error(t, r.RunMigrations(ctx))
+	// the completed steps are recorded, the failed one is not
+	require.Equal(t, uint64(2), r.GetStoredVersion(ctx, "a"))
+	require.Panics(t, func() { r.UpgradeHandler()(ctx, upgrade.Plan{Name: "test"}) })
+
+	// a store newer than the code is rejected
+	ctx.KVStore(keyA).Set(VersionKey, []byte{0, 0, 0, 0, 0, 0, 0, 4})
+	require.Error(t, r.RunMigrations(ctx))
+}
+
+func Test_InitVersions(t *testing.T) {
+	keyA, keyB := sdk.NewKVStoreKey("a"), sdk.NewKVStoreKey("b")
+	ctx := fixtureContext(t, keyA, keyB)
+
+	r := NewRegistry()
+	r.RegisterModule("a", keyA, 2)
+	r.RegisterModule("b", keyB, 1)
+	r.RegisterMigration("a", 1, func(ctx sdk.Context) error { return fmt.Errorf("should not run") })
+
+	r.InitVersions(ctx)
+	require.Equal(t, uint64(2), r.GetStoredVersion(ctx, "a"))
+	require.Equal(t, uint64(1), r.GetStoredVersion(ctx, "b"))
+	require.Nil(t, r.RunMigrations(ctx))
+}
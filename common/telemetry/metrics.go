@@ -0,0 +1,222 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package telemetry holds the metrics of the cross chain traffic of the bridge modules. The metrics are no-op until
+// EnablePrometheus is called, they are then registered to the default prometheus registry, which is served by the
+// tendermint prometheus endpoint once `instrumentation.prometheus` is turned on in the node config.
+package telemetry
+
+import (
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this package.
+	MetricsSubsystem = "poly"
+	// DefaultNamespace is the default namespace of the tendermint metrics, `instrumentation.namespace` in the node config
+	DefaultNamespace = "tendermint"
+
+	// label values of InboundTxs
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+	// label values of Headers
+	StatusAccepted = "accepted"
+	StatusRejected = "rejected"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of outgoing cross chain txs, labeled by to_chain_id.
+	OutgoingTxs metrics.Counter
+	// Histogram of the args sizes of outgoing cross chain txs in bytes, labeled by to_chain_id.
+	OutgoingTxArgsBytes metrics.Histogram
+	// Number of inbound cross chain txs, labeled by from_chain_id, status and error_class, the stage a failed tx stopped at.
+	InboundTxs metrics.Counter
+	// Histogram of the processing time of inbound cross chain txs in seconds, labeled by from_chain_id.
+	InboundTxDuration metrics.Histogram
+	// Number of poly headers processed, labeled by chain_id and status.
+	Headers metrics.Counter
+	// Number of poly consensus epoch rotations, labeled by chain_id.
+	EpochRotations metrics.Counter
+	// Amount of coins locked for leaving the chain, labeled by module and denom.
+	LockedAmount metrics.Counter
+	// Amount of coins unlocked on entering the chain, labeled by module and denom.
+	UnlockedAmount metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		OutgoingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "outgoing_txs",
+			Help:      "Number of outgoing cross chain txs.",
+		}, []string{"to_chain_id"}),
+		OutgoingTxArgsBytes: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "outgoing_tx_args_bytes",
+			Help:      "Args sizes of outgoing cross chain txs in bytes.",
+			Buckets:   stdprometheus.ExponentialBuckets(16, 2, 10),
+		}, []string{"to_chain_id"}),
+		InboundTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "inbound_txs",
+			Help:      "Number of inbound cross chain txs.",
+		}, []string{"from_chain_id", "status", "error_class"}),
+		InboundTxDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "inbound_tx_duration_seconds",
+			Help:      "Processing time of inbound cross chain txs in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0005, 2, 12),
+		}, []string{"from_chain_id"}),
+		Headers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "headers",
+			Help:      "Number of poly headers processed.",
+		}, []string{"chain_id", "status"}),
+		EpochRotations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "epoch_rotations",
+			Help:      "Number of poly consensus epoch rotations.",
+		}, []string{"chain_id"}),
+		LockedAmount: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "locked_amount",
+			Help:      "Amount of coins locked for leaving the chain.",
+		}, []string{"module", "denom"}),
+		UnlockedAmount: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "unlocked_amount",
+			Help:      "Amount of coins unlocked on entering the chain.",
+		}, []string{"module", "denom"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		OutgoingTxs:         discard.NewCounter(),
+		OutgoingTxArgsBytes: discard.NewHistogram(),
+		InboundTxs:          discard.NewCounter(),
+		InboundTxDuration:   discard.NewHistogram(),
+		Headers:             discard.NewCounter(),
+		EpochRotations:      discard.NewCounter(),
+		LockedAmount:        discard.NewCounter(),
+		UnlockedAmount:      discard.NewCounter(),
+	}
+}
+
+var (
+	current    = NopMetrics()
+	enableOnce sync.Once
+)
+
+// EnablePrometheus switches the metrics to prometheus ones under namespace, only the first call has effect
+// since prometheus refuses registering the same metric twice
+func EnablePrometheus(namespace string) {
+	enableOnce.Do(func() {
+		current = PrometheusMetrics(namespace)
+	})
+}
+
+// Get returns the metrics in use
+func Get() *Metrics {
+	return current
+}
+
+// the state changes of CheckTx, ReCheckTx and simulations are thrown away, they are not counted
+func skip(ctx sdk.Context) bool {
+	return ctx.IsCheckTx()
+}
+
+func RecordOutgoingTx(ctx sdk.Context, toChainId uint64, argsLen int) {
+	if skip(ctx) {
+		return
+	}
+	toChain := strconv.FormatUint(toChainId, 10)
+	current.OutgoingTxs.With("to_chain_id", toChain).Add(1)
+	current.OutgoingTxArgsBytes.With("to_chain_id", toChain).Observe(float64(argsLen))
+}
+
+// RecordInboundTx records an inbound tx started at start, errorClass is empty on success
+func RecordInboundTx(ctx sdk.Context, fromChainId uint64, errorClass string, start time.Time) {
+	if skip(ctx) {
+		return
+	}
+	fromChain := strconv.FormatUint(fromChainId, 10)
+	status := StatusSuccess
+	if errorClass != "" {
+		status = StatusFailure
+	}
+	current.InboundTxs.With("from_chain_id", fromChain, "status", status, "error_class", errorClass).Add(1)
+	current.InboundTxDuration.With("from_chain_id", fromChain).Observe(time.Since(start).Seconds())
+}
+
+func RecordHeader(ctx sdk.Context, chainId uint64, accepted bool) {
+	if skip(ctx) {
+		return
+	}
+	status := StatusAccepted
+	if !accepted {
+		status = StatusRejected
+	}
+	current.Headers.With("chain_id", strconv.FormatUint(chainId, 10), "status", status).Add(1)
+}
+
+func RecordEpochRotation(ctx sdk.Context, chainId uint64) {
+	if skip(ctx) {
+		return
+	}
+	current.EpochRotations.With("chain_id", strconv.FormatUint(chainId, 10)).Add(1)
+}
+
+func RecordLock(ctx sdk.Context, module, denom string, amount sdk.Int) {
+	if skip(ctx) {
+		return
+	}
+	current.LockedAmount.With("module", module, "denom", denom).Add(amountToFloat(amount))
+}
+
+func RecordUnlock(ctx sdk.Context, module, denom string, amount sdk.Int) {
+	if skip(ctx) {
+		return
+	}
+	current.UnlockedAmount.With("module", module, "denom", denom).Add(amountToFloat(amount))
+}
+
+// amountToFloat converts amount to the float64 prometheus works with, precision is lost beyond 2^53
+func amountToFloat(amount sdk.Int) float64 {
+	f, _ := new(big.Float).SetInt(amount.BigInt()).Float64()
+	return f
+}
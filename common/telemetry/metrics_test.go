@@ -0,0 +1,93 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// sumCounter sums up whatever the labels
+type sumCounter struct {
+	value float64
+}
+
+func (c *sumCounter) With(...string) metrics.Counter { return c }
+func (c *sumCounter) Add(delta float64)              { c.value += delta }
+
+func testMetrics() *Metrics {
+	return &Metrics{
+		OutgoingTxs:         &sumCounter{},
+		OutgoingTxArgsBytes: discard.NewHistogram(),
+		InboundTxs:          &sumCounter{},
+		InboundTxDuration:   discard.NewHistogram(),
+		Headers:             &sumCounter{},
+		EpochRotations:      &sumCounter{},
+		LockedAmount:        &sumCounter{},
+		UnlockedAmount:      &sumCounter{},
+	}
+}
+
+func Test_telemetry_Record(t *testing.T) {
+	saved := current
+	defer func() { current = saved }()
+	current = testMetrics()
+
+	deliverCtx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	checkCtx := sdk.NewContext(nil, abci.Header{}, true, log.NewNopLogger())
+
+	RecordOutgoingTx(deliverCtx, 2, 100)
+	RecordOutgoingTx(checkCtx, 2, 100)
+	require.Equal(t, float64(1), current.OutgoingTxs.(*sumCounter).value)
+
+	RecordInboundTx(deliverCtx, 2, "", time.Now())
+	RecordInboundTx(deliverCtx, 2, "verify_proof", time.Now())
+	RecordInboundTx(checkCtx, 2, "", time.Now())
+	require.Equal(t, float64(2), current.InboundTxs.(*sumCounter).value)
+
+	RecordHeader(deliverCtx, 0, true)
+	RecordEpochRotation(deliverCtx, 0)
+	RecordEpochRotation(checkCtx, 0)
+	require.Equal(t, float64(1), current.Headers.(*sumCounter).value)
+	require.Equal(t, float64(1), current.EpochRotations.(*sumCounter).value)
+
+	RecordLock(deliverCtx, "lockproxy", "stake", sdk.NewInt(30))
+	RecordLock(deliverCtx, "lockproxy", "stake", sdk.NewInt(12))
+	RecordUnlock(deliverCtx, "lockproxy", "stake", sdk.NewInt(5))
+	RecordUnlock(checkCtx, "lockproxy", "stake", sdk.NewInt(5))
+	require.Equal(t, float64(42), current.LockedAmount.(*sumCounter).value)
+	require.Equal(t, float64(5), current.UnlockedAmount.(*sumCounter).value)
+}
+
+func Test_telemetry_EnablePrometheus(t *testing.T) {
+	saved := current
+	defer func() { current = saved }()
+
+	EnablePrometheus("test")
+	metrics := Get()
+	// a second call must neither register again, which would panic, nor replace the metrics
+	EnablePrometheus("test")
+	require.Equal(t, metrics, Get())
+}
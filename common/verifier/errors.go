@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package verifier
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codespace of the errors returned by the verifier
+const Codespace = "verifier"
+
+var (
+	ErrConsensusPeersType         = sdkerrors.Register(Codespace, 1, "ErrConsensusPeersType")
+	ErrHeaderHeightType           = sdkerrors.Register(Codespace, 2, "ErrHeaderHeightType")
+	ErrBookKeeperNumType          = sdkerrors.Register(Codespace, 3, "ErrBookKeeperNumType")
+	ErrInvalidPublicKeyType       = sdkerrors.Register(Codespace, 4, "ErrInvalidPublicKeyType")
+	ErrVerifyMultiSigType         = sdkerrors.Register(Codespace, 5, "ErrVerifyMultiSigType")
+	ErrKeyHeaderHashType          = sdkerrors.Register(Codespace, 6, "ErrKeyHeaderHashType")
+	ErrHistoricalHeaderType       = sdkerrors.Register(Codespace, 7, "ErrHistoricalHeaderType")
+	ErrMerkleProveType            = sdkerrors.Register(Codespace, 8, "ErrMerkleProveType")
+	ErrDeserializeMerkleValueType = sdkerrors.Register(Codespace, 9, "ErrDeserializeMerkleValueType")
+	ErrToChainIdType              = sdkerrors.Register(Codespace, 10, "ErrToChainIdType")
+)
+
+func ErrConsensusPeers(reason string) error {
+	return sdkerrors.Wrapf(ErrConsensusPeersType, "Reason: %s", reason)
+}
+
+func ErrHeaderHeight(chainId uint64, height uint32, peersHeight uint32) error {
+	return sdkerrors.Wrapf(ErrHeaderHeightType, "chainId: %d, stored consensus header.Height: %d, trying to sync height: %d", chainId, peersHeight, height)
+}
+
+func ErrBookKeeperNum(headerBookKeeperNum int, consensusNodeNum int) error {
+	return sdkerrors.Wrap(ErrBookKeeperNumType, fmt.Sprintf("Header Bookkeepers number: %d must more than 2/3 consensus node number: %d", headerBookKeeperNum, consensusNodeNum))
+}
+
+func ErrInvalidPublicKey(pubkey string) error {
+	return sdkerrors.Wrap(ErrInvalidPublicKeyType, fmt.Sprintf("Invalid pubkey: %s", pubkey))
+}
+
+func ErrVerifyMultiSig(err error, height uint32) error {
+	return sdkerrors.Wrap(ErrVerifyMultiSigType, fmt.Sprintf("Verify multi signature Error: %s of height: %d", err.Error(), height))
+}
+
+func ErrKeyHeaderHash(reason string) error {
+	return sdkerrors.Wrapf(ErrKeyHeaderHashType, "Reason: %s", reason)
+}
+
+func ErrHistoricalHeader(reason string) error {
+	return sdkerrors.Wrapf(ErrHistoricalHeaderType, "Reason: %s", reason)
+}
+
+func ErrMerkleProve(err error) error {
+	return sdkerrors.Wrapf(ErrMerkleProveType, "merkle.MerkleProve verify failed, Error: %s", err.Error())
+}
+
+func ErrDeserializeMerkleValue(reason string) error {
+	return sdkerrors.Wrapf(ErrDeserializeMerkleValueType, "Reason: %s", reason)
+}
+
+func ErrToChainId(expect, got uint64) error {
+	return sdkerrors.Wrapf(ErrToChainIdType, "toChainId is not for this chain, expect: %d, got: %d", expect, got)
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package verifier holds the verification of the poly chain headers and of the cross chain txs they carry, as run
+// by the headersync and ccm modules but without any store: the consensus peers and the key header hash the modules
+// keep in their stores are taken explicitly, so that relayers, wallets or monitors can check off-chain exactly what
+// the chain would accept
+package verifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	polycommon "github.com/polynetwork/poly/common"
+	vconfig "github.com/polynetwork/poly/consensus/vbft/config"
+	polysig "github.com/polynetwork/poly/core/signature"
+	polytype "github.com/polynetwork/poly/core/types"
+	"github.com/polynetwork/poly/merkle"
+)
+
+// VerifyHeaderSig verifies that header is above the height of peers, the consensus peers of its chain, and that
+// it is signed by at least 2/3 of them
+func VerifyHeaderSig(header *polytype.Header, peers *ConsensusPeers) error {
+	if peers == nil || peers.ChainID != header.ChainID {
+		return ErrConsensusPeers(fmt.Sprintf("no consensus peers of chainId: %d", header.ChainID))
+	}
+	if header.Height <= peers.Height {
+		return ErrHeaderHeight(header.ChainID, header.Height, peers.Height)
+	}
+
+	if len(header.Bookkeepers)*3 < len(peers.PeerMap)*2 {
+		return ErrBookKeeperNum(len(header.Bookkeepers), len(peers.PeerMap))
+	}
+	for _, bookkeeper := range header.Bookkeepers {
+		pubkey := vconfig.PubkeyID(bookkeeper)
+		if _, present := peers.PeerMap[pubkey]; !present {
+			return ErrInvalidPublicKey(pubkey)
+		}
+	}
+	hash := header.Hash()
+	if err := polysig.VerifyMultiSignature(hash[:], header.Bookkeepers, len(header.Bookkeepers), header.SigData); err != nil {
+		return ErrVerifyMultiSig(err, header.Height)
+	}
+	return nil
+}
+
+// VerifyHeaderByKeyHeaderHash verifies that header is the key header of its chain, i.e. the last header
+// which changed its consensus peers
+func VerifyHeaderByKeyHeaderHash(header *polytype.Header, keyHeaderHash polycommon.Uint256) error {
+	headerHash := header.Hash()
+	if headerHash != keyHeaderHash {
+		return ErrKeyHeaderHash(fmt.Sprintf("not equal, expect: %x, got: %x", keyHeaderHash.ToArray(), headerHash.ToArray()))
+	}
+	return nil
+}
+
+// VerifyHistoricalHeader verifies header through curHeader, a header of the current epoch signed by peers, and
+// headerProof, the audit path of header where the root is curHeader.BlockRoot
+func VerifyHistoricalHeader(header *polytype.Header, headerProof []byte, curHeader *polytype.Header, peers *ConsensusPeers) error {
+	if err := VerifyHeaderSig(curHeader, peers); err != nil {
+		return err
+	}
+	value, err := merkle.MerkleProve(headerProof, curHeader.BlockRoot[:])
+	if err != nil {
+		return ErrHistoricalHeader(fmt.Sprintf("MerkleProve error: %s", err.Error()))
+	}
+	hashToBeVerified := header.Hash()
+	if !bytes.Equal(value, hashToBeVerified[:]) {
+		return ErrHistoricalHeader(fmt.Sprintf("historical header height: %d, current epoch header height: %d, expect: %x, got: %x", header.Height, curHeader.Height, hashToBeVerified[:], value))
+	}
+	return nil
+}
+
+// VerifyHeader verifies header the way the headersync module does before accepting it: through curHeader and
+// headerProof when both are given, otherwise by its signatures or, failing that, as the key header of its chain.
+// keyHeaderHash may be nil if the chain has none. The returned header is the one whose NewChainConfig, if any,
+// rotates the consensus peers, it is nil when header is only accepted as the key header
+func VerifyHeader(header *polytype.Header, headerProof []byte, curHeader *polytype.Header, peers *ConsensusPeers, keyHeaderHash *polycommon.Uint256) (*polytype.Header, error) {
+	if curHeader == nil || headerProof == nil {
+		if err := VerifyHeaderSig(header, peers); err != nil {
+			if keyHeaderHash != nil && VerifyHeaderByKeyHeaderHash(header, *keyHeaderHash) == nil {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return header, nil
+	}
+	if err := VerifyHistoricalHeader(header, headerProof, curHeader, peers); err != nil {
+		return nil, err
+	}
+	return curHeader, nil
+}
+
+// NewConsensusPeers returns the consensus peers set by the NewChainConfig of header, nil if header carries none
+func NewConsensusPeers(header *polytype.Header) (*ConsensusPeers, error) {
+	blkInfo := &vconfig.VbftBlockInfo{}
+	if err := json.Unmarshal(header.ConsensusPayload, blkInfo); err != nil {
+		return nil, ErrConsensusPeers(fmt.Sprintf("unmarshal consensus payload of height: %d, Error: %s", header.Height, err.Error()))
+	}
+	if blkInfo.NewChainConfig == nil {
+		return nil, nil
+	}
+	consensusPeers := &ConsensusPeers{
+		ChainID: header.ChainID,
+		Height:  header.Height,
+		PeerMap: make(map[string]*Peer),
+	}
+	for _, p := range blkInfo.NewChainConfig.Peers {
+		consensusPeers.PeerMap[p.ID] = &Peer{Index: p.Index, PeerPubkey: p.ID}
+	}
+	return consensusPeers, nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package verifier
+
+import (
+	"fmt"
+
+	polycommon "github.com/polynetwork/poly/common"
+	polytype "github.com/polynetwork/poly/core/types"
+	"github.com/polynetwork/poly/merkle"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+)
+
+// VerifyToCosmosTx verifies proof, the audit path of a cross chain tx where the root is header.CrossStateRoot,
+// and returns the tx
+func VerifyToCosmosTx(proof []byte, header *polytype.Header) (*ccmc.ToMerkleValue, error) {
+	value, err := merkle.MerkleProve(proof, header.CrossStateRoot[:])
+	if err != nil {
+		return nil, ErrMerkleProve(err)
+	}
+	return decodeToMerkleValue(value)
+}
+
+// PeekToMerkleValue decodes the cross chain tx carried by proof without verifying the proof, its leaf value
+// comes first in it
+func PeekToMerkleValue(proof []byte) (*ccmc.ToMerkleValue, error) {
+	value, eof := polycommon.NewZeroCopySource(proof).NextVarBytes()
+	if eof {
+		return nil, ErrDeserializeMerkleValue("read merkle value from proof error")
+	}
+	return decodeToMerkleValue(value)
+}
+
+func decodeToMerkleValue(value []byte) (*ccmc.ToMerkleValue, error) {
+	merkleValue := new(ccmc.ToMerkleValue)
+	if err := merkleValue.Deserialization(polycommon.NewZeroCopySource(value)); err != nil {
+		return nil, ErrDeserializeMerkleValue(fmt.Sprintf("ToMerkeValue Deserialization Error: %s", err.Error()))
+	}
+	return merkleValue, nil
+}
+
+// VerifyCrossChainTx verifies a cross chain tx towards toChainId the way the ccm module does before executing it:
+// header is verified by VerifyHeader against peers and keyHeaderHash, then proof against header. Whether the tx
+// has already been done is kept in the store of the ccm module, so it is left to the caller
+func VerifyCrossChainTx(toChainId uint64, proof []byte, header *polytype.Header, headerProof []byte, curHeader *polytype.Header,
+	peers *ConsensusPeers, keyHeaderHash *polycommon.Uint256) (*ccmc.ToMerkleValue, error) {
+	if _, err := VerifyHeader(header, headerProof, curHeader, peers, keyHeaderHash); err != nil {
+		return nil, err
+	}
+	merkleValue, err := VerifyToCosmosTx(proof, header)
+	if err != nil {
+		return nil, err
+	}
+	if merkleValue.MakeTxParam.ToChainID != toChainId {
+		return nil, ErrToChainId(toChainId, merkleValue.MakeTxParam.ToChainID)
+	}
+	return merkleValue, nil
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package verifier_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/polynetwork/cosmos-poly-module/common/verifier"
+	"github.com/polynetwork/poly/account"
+	polycommon "github.com/polynetwork/poly/common"
+	vconfig "github.com/polynetwork/poly/consensus/vbft/config"
+	polysig "github.com/polynetwork/poly/core/signature"
+	polytype "github.com/polynetwork/poly/core/types"
+	"github.com/polynetwork/poly/merkle"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+	"github.com/stretchr/testify/require"
+)
+
+func newPeers(t *testing.T, n int, height uint32) ([]*account.Account, *verifier.ConsensusPeers) {
+	accs := make([]*account.Account, n)
+	peers := &verifier.ConsensusPeers{ChainID: 0, Height: height, PeerMap: make(map[string]*verifier.Peer)}
+	for i := range accs {
+		accs[i] = account.NewAccount("")
+		id := vconfig.PubkeyID(accs[i].PublicKey)
+		peers.PeerMap[id] = &verifier.Peer{Index: uint32(i), PeerPubkey: id}
+	}
+	return accs, peers
+}
+
+// signHeader signs header by signers once all its other fields are set, as its hash is cached
+func signHeader(t *testing.T, header *polytype.Header, signers []*account.Account) *polytype.Header {
+	hash := header.Hash()
+	for _, acc := range signers {
+		sig, err := polysig.Sign(acc, hash[:])
+		require.Nil(t, err)
+		header.Bookkeepers = append(header.Bookkeepers, acc.PublicKey)
+		header.SigData = append(header.SigData, sig)
+	}
+	return header
+}
+
+// leafProof returns the audit path of value in a tree made of it only, whose root is merkle.HashLeaf(value)
+func leafProof(value []byte) []byte {
+	sink := polycommon.NewZeroCopySink(nil)
+	sink.WriteVarBytes(value)
+	return sink.Bytes()
+}
+
+func makeToMerkleValue(toChainId uint64) []byte {
+	sink := polycommon.NewZeroCopySink(nil)
+	merkleValue := &ccmc.ToMerkleValue{
+		TxHash:      []byte("txhash"),
+		FromChainID: 2,
+		MakeTxParam: &ccmc.MakeTxParam{
+			TxHash:              []byte("txhash"),
+			CrossChainID:        []byte{1},
+			FromContractAddress: []byte("from"),
+			ToChainID:           toChainId,
+			ToContractAddress:   []byte("to"),
+			Method:              "unlock",
+			Args:                []byte("args"),
+		},
+	}
+	merkleValue.Serialization(sink)
+	return sink.Bytes()
+}
+
+func TestVerifyHeaderSig(t *testing.T) {
+	accs, peers := newPeers(t, 4, 10)
+
+	require.Nil(t, verifier.VerifyHeaderSig(signHeader(t, &polytype.Header{Height: 11}, accs[:3]), peers))
+
+	testCases := []struct {
+		name   string
+		header *polytype.Header
+		peers  *verifier.ConsensusPeers
+		err    error
+	}{
+		{"no peers", signHeader(t, &polytype.Header{Height: 11}, accs), nil, verifier.ErrConsensusPeersType},
+		{"peers of another chain", signHeader(t, &polytype.Header{ChainID: 1, Height: 11}, accs), peers, verifier.ErrConsensusPeersType},
+		{"not above the peers", signHeader(t, &polytype.Header{Height: 10}, accs), peers, verifier.ErrHeaderHeightType},
+		{"less than 2/3", signHeader(t, &polytype.Header{Height: 11}, accs[:2]), peers, verifier.ErrBookKeeperNumType},
+		{"unknown bookkeeper", signHeader(t, &polytype.Header{Height: 11}, append(accs[:2:2], account.NewAccount(""))), peers, verifier.ErrInvalidPublicKeyType},
+	}
+	for _, tc := range testCases {
+		err := verifier.VerifyHeaderSig(tc.header, tc.peers)
+		require.Error(t, err, tc.name)
+		require.True(t, errors.Is(err, tc.err), "%s: %s", tc.name, err)
+	}
+
+	forged := signHeader(t, &polytype.Header{Height: 11}, accs[:3])
+	forged.SigData[0] = forged.SigData[1]
+	require.True(t, errors.Is(verifier.VerifyHeaderSig(forged, peers), verifier.ErrVerifyMultiSigType))
+}
+
+func TestVerifyHeader(t *testing.T) {
+	accs, peers := newPeers(t, 4, 10)
+	header := signHeader(t, &polytype.Header{Height: 11}, accs)
+
+	epochHeader, err := verifier.VerifyHeader(header, nil, nil, peers, nil)
+	require.Nil(t, err)
+	require.Equal(t, header, epochHeader)
+
+	// the key header is accepted even though it is not above the peers
+	keyHeader := signHeader(t, &polytype.Header{Height: 10}, accs)
+	keyHeaderHash := keyHeader.Hash()
+	_, err = verifier.VerifyHeader(keyHeader, nil, nil, peers, nil)
+	require.Error(t, err)
+	epochHeader, err = verifier.VerifyHeader(keyHeader, nil, nil, peers, &keyHeaderHash)
+	require.Nil(t, err)
+	require.Nil(t, epochHeader)
+
+	// a historical header is verified through a header of the current epoch
+	historical := &polytype.Header{Height: 5}
+	historicalHash := historical.Hash()
+	curHeader := signHeader(t, &polytype.Header{Height: 12, BlockRoot: merkle.HashLeaf(historicalHash[:])}, accs)
+	epochHeader, err = verifier.VerifyHeader(historical, leafProof(historicalHash[:]), curHeader, peers, nil)
+	require.Nil(t, err)
+	require.Equal(t, curHeader, epochHeader)
+	_, err = verifier.VerifyHeader(&polytype.Header{Height: 6}, leafProof(historicalHash[:]), curHeader, peers, nil)
+	require.True(t, errors.Is(err, verifier.ErrHistoricalHeaderType))
+}
+
+func TestVerifyCrossChainTx(t *testing.T) {
+	accs, peers := newPeers(t, 4, 10)
+	value := makeToMerkleValue(5)
+	header := signHeader(t, &polytype.Header{Height: 11, CrossStateRoot: merkle.HashLeaf(value)}, accs)
+
+	merkleValue, err := verifier.PeekToMerkleValue(leafProof(value))
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), merkleValue.MakeTxParam.ToChainID)
+
+	merkleValue, err = verifier.VerifyCrossChainTx(5, leafProof(value), header, nil, nil, peers, nil)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), merkleValue.FromChainID)
+
+	_, err = verifier.VerifyCrossChainTx(6, leafProof(value), header, nil, nil, peers, nil)
+	require.True(t, errors.Is(err, verifier.ErrToChainIdType))
+	_, err = verifier.VerifyCrossChainTx(5, leafProof(makeToMerkleValue(6)), header, nil, nil, peers, nil)
+	require.True(t, errors.Is(err, verifier.ErrMerkleProveType))
+	_, err = verifier.VerifyCrossChainTx(5, leafProof(value), header, nil, nil, &verifier.ConsensusPeers{Height: 10}, nil)
+	require.True(t, errors.Is(err, verifier.ErrBookKeeperNumType) || errors.Is(err, verifier.ErrInvalidPublicKeyType))
+}
+
+func TestNewConsensusPeers(t *testing.T) {
+	accs, peers := newPeers(t, 2, 20)
+	config := &vconfig.ChainConfig{}
+	for _, acc := range accs {
+		id := vconfig.PubkeyID(acc.PublicKey)
+		config.Peers = append(config.Peers, &vconfig.PeerConfig{Index: peers.PeerMap[id].Index, ID: id})
+	}
+	payload, err := json.Marshal(&vconfig.VbftBlockInfo{NewChainConfig: config})
+	require.Nil(t, err)
+
+	newPeers, err := verifier.NewConsensusPeers(&polytype.Header{Height: 20, ConsensusPayload: payload})
+	require.Nil(t, err)
+	require.Equal(t, peers, newPeers)
+
+	payload, err = json.Marshal(&vconfig.VbftBlockInfo{})
+	require.Nil(t, err)
+	newPeers, err = verifier.NewConsensusPeers(&polytype.Header{Height: 20, ConsensusPayload: payload})
+	require.Nil(t, err)
+	require.Nil(t, newPeers)
+
+	_, err = verifier.NewConsensusPeers(&polytype.Header{Height: 20, ConsensusPayload: []byte("{")})
+	require.Error(t, err)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package datarelay
+
+import (
+	"github.com/polynetwork/cosmos-poly-module/datarelay/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/datarelay/internal/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
+	StoreKey          = types.StoreKey
+	QuerierRoute      = types.QuerierRoute
+	QueryParameters   = types.QueryParameters
+	QueryData         = types.QueryData
+	RouterKey         = types.RouterKey
+	ConsensusVersion  = types.ConsensusVersion
+	MethodStoreData   = types.MethodStoreData
+	MaxTopicLength    = types.MaxTopicLength
+
+	AttributeValueCategory = types.AttributeValueCategory
+
+	EventTypeStoreData       = types.EventTypeStoreData
+	AttributeKeyFromChainId  = types.AttributeKeyFromChainId
+	AttributeKeyFromContract = types.AttributeKeyFromContract
+	AttributeKeyTopic        = types.AttributeKeyTopic
+	AttributeKeyPolyHeight   = types.AttributeKeyPolyHeight
+	AttributeKeyStored       = types.AttributeKeyStored
+
+	EventTypePublish          = types.EventTypePublish
+	AttributeKeyPublisher     = types.AttributeKeyPublisher
+	AttributeKeyPublisherHash = types.AttributeKeyPublisherHash
+	AttributeKeyToChainId     = types.AttributeKeyToChainId
+	AttributeKeyToContract    = types.AttributeKeyToContract
+)
+
+var (
+	// functions aliases
+	RegisterCodec            = types.RegisterCodec
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	ValidateGenesis          = types.ValidateGenesis
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	NewSender                = types.NewSender
+	NewMsgPublish            = types.NewMsgPublish
+	NewQueryDataParam        = types.NewQueryDataParam
+	GetPublisherContractHash = types.GetPublisherContractHash
+	ValidateTopic            = types.ValidateTopic
+
+	// key function
+	GetDataKey = keeper.GetDataKey
+
+	ModuleCdc            = types.ModuleCdc
+	ContractHash         = types.ContractHash
+	KeyRegisteredSenders = types.KeyRegisteredSenders
+
+	ErrMsgPublishType          = types.ErrMsgPublishType
+	ErrPublishType             = types.ErrPublishType
+	ErrStoreDataType           = types.ErrStoreDataType
+	ErrSenderNotRegisteredType = types.ErrSenderNotRegisteredType
+	ErrDataNotFoundType        = types.ErrDataNotFoundType
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenesisState   = types.GenesisState
+	Params         = types.Params
+	Sender         = types.Sender
+	StoredData     = types.StoredData
+	StoreDataArgs  = types.StoreDataArgs
+	MsgPublish     = types.MsgPublish
+	QueryDataParam = types.QueryDataParam
+)
@@ -0,0 +1,120 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/polynetwork/cosmos-poly-module/datarelay/client/common"
+	"github.com/polynetwork/cosmos-poly-module/datarelay/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the datarelay module.
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdQueryData(queryRoute, cdc),
+		)...,
+	)
+
+	return queryCmd
+}
+
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameters",
+		Args:  cobra.NoArgs,
+		Short: "Query the parameters of datarelay module, including the registered senders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s parameters
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryParams(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+			fmt.Printf("Paramters res is:\n %s\n", params.String())
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryData(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "data [from_chain_id] [from_contract] [topic]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the latest data stored under topic by from_contract of from_chain_id, with its Poly height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the latest data stored under topic by the contract from_contract, in hex format, of the chain from_chain_id
+
+Example:
+$ %s query %s data 2 34d4a23a1fc0c694f0d74ddaf9d8d564cfe2d430 ETH/USD
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			fromChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			fromContract, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("decode hex string 'from_contract' error:%v", err)
+			}
+			res, err := common.QueryData(cliCtx, queryRoute, fromChainId, fromContract, args[2])
+			if err != nil {
+				return err
+			}
+			var data types.StoredData
+			cdc.MustUnmarshalJSON(res, &data)
+			return cliCtx.PrintOutput(data)
+		},
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/polynetwork/cosmos-poly-module/datarelay/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the datarelay store
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace params.Subspace
+	ccmKeeper  types.CrossChainManager
+}
+
+// NewKeeper creates a new datarelay Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ccmKeeper types.CrossChainManager) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		ccmKeeper:  ccmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of datarelay parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of datarelay parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetRegisteredSenders returns the contracts of other chains whose data is accepted, none before it is set
+func (k Keeper) GetRegisteredSenders(ctx sdk.Context) []types.Sender {
+	var senders []types.Sender
+	k.paramSpace.GetIfExists(ctx, types.KeyRegisteredSenders, &senders)
+	return senders
+}
+
+func (k Keeper) IsRegisteredSender(ctx sdk.Context, fromChainId uint64, fromContract []byte) bool {
+	for _, sender := range k.GetRegisteredSenders(ctx) {
+		if sender.Matches(fromChainId, fromContract) {
+			return true
+		}
+	}
+	return false
+}
+
+// StoreData is called by ccm for the "storeData" cross chain txs, it keeps the payload under its topic in the
+// namespace of the sender. A payload proven against an older Poly header than the stored one is acknowledged
+// without overwriting it, so that relaying txs out of order never rolls the data of a topic back
+func (k Keeper) StoreData(ctx sdk.Context, fromChainId uint64, fromContractAddr []byte, toContractAddr []byte, argsBs []byte, polyHeight uint32) error {
+	if !bytes.Equal(toContractAddr, types.ContractHash) {
+		return types.ErrStoreData(fmt.Sprintf("toContractAddr: %x is not the data relay contract hash: %x", toContractAddr, types.ContractHash))
+	}
+	if !k.IsRegisteredSender(ctx, fromChainId, fromContractAddr) {
+		return types.ErrSenderNotRegistered(fromChainId, fromContractAddr)
+	}
+	args := new(types.StoreDataArgs)
+	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs)); err != nil {
+		return types.ErrStoreData(fmt.Sprintf("StoreDataArgs Deserialization Error: %s", err.Error()))
+	}
+	if err := types.ValidateTopic(args.Topic); err != nil {
+		return types.ErrStoreData(err.Error())
+	}
+
+	stored := true
+	if old, found := k.GetData(ctx, fromChainId, fromContractAddr, args.Topic); found && old.PolyHeight > polyHeight {
+		stored = false
+	}
+	if stored {
+		k.SetData(ctx, types.StoredData{
+			FromChainId:  fromChainId,
+			FromContract: fromContractAddr,
+			Topic:        args.Topic,
+			Data:         args.Data,
+			PolyHeight:   polyHeight,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreData,
+			sdk.NewAttribute(types.AttributeKeyFromChainId, strconv.FormatUint(fromChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFromContract, hex.EncodeToString(fromContractAddr)),
+			sdk.NewAttribute(types.AttributeKeyTopic, args.Topic),
+			sdk.NewAttribute(types.AttributeKeyPolyHeight, strconv.FormatUint(uint64(polyHeight), 10)),
+			sdk.NewAttribute(types.AttributeKeyStored, strconv.FormatBool(stored)),
+		),
+	)
+	return nil
+}
+
+// GetData returns the latest data stored under topic by the contract fromContract of the chain fromChainId
+func (k Keeper) GetData(ctx sdk.Context, fromChainId uint64, fromContract []byte, topic string) (types.StoredData, bool) {
+	var data types.StoredData
+	bz := ctx.KVStore(k.storeKey).Get(GetDataKey(fromChainId, fromContract, topic))
+	if bz == nil {
+		return data, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &data)
+	return data, true
+}
+
+// SetData records data under its topic in the namespace of its sender as it is, without the checks of StoreData
+func (k Keeper) SetData(ctx sdk.Context, data types.StoredData) {
+	ctx.KVStore(k.storeKey).Set(GetDataKey(data.FromChainId, data.FromContract, data.Topic), k.cdc.MustMarshalBinaryBare(data))
+}
+
+// GetAllData returns all the stored data, ordered by sender and topic
+func (k Keeper) GetAllData(ctx sdk.Context) []types.StoredData {
+	all := []types.StoredData{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), DataPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var data types.StoredData
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &data)
+		all = append(all, data)
+	}
+	return all
+}
+
+// Publish creates a "storeData" cross chain tx carrying data under topic towards the contract toContract of the
+// chain toChainId, the receiving side sees it coming from the publisher contract hash of publisher
+func (k Keeper) Publish(ctx sdk.Context, publisher sdk.AccAddress, toChainId uint64, toContract []byte, topic string, data []byte) error {
+	if err := types.ValidateTopic(topic); err != nil {
+		return types.ErrPublish(err.Error())
+	}
+	args := types.StoreDataArgs{Topic: topic, Data: data}
+	sink := polycommon.NewZeroCopySink(nil)
+	args.Serialization(sink)
+
+	publisherHash := types.GetPublisherContractHash(publisher)
+	if err := k.ccmKeeper.CreateCrossChainTx(ctx, publisher, toChainId, publisherHash, toContract, types.MethodStoreData, sink.Bytes()); err != nil {
+		return types.ErrPublish(fmt.Sprintf("ccmKeeper.CreateCrossChainTx Error: %s", err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePublish,
+			sdk.NewAttribute(types.AttributeKeyPublisher, publisher.String()),
+			sdk.NewAttribute(types.AttributeKeyPublisherHash, hex.EncodeToString(publisherHash)),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToContract, hex.EncodeToString(toContract)),
+			sdk.NewAttribute(types.AttributeKeyTopic, topic),
+		),
+	)
+	return nil
+}
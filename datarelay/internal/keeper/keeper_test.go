@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
+	"github.com/polynetwork/cosmos-poly-module/datarelay"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	return app, ctx
+}
+
+func storeDataArgs(topic string, data []byte) []byte {
+	sink := polycommon.NewZeroCopySink(nil)
+	args := datarelay.StoreDataArgs{Topic: topic, Data: data}
+	args.Serialization(sink)
+	return sink.Bytes()
+}
+
+func Test_datarelay_StoreData(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.DataRelayKeeper
+
+	sender, _ := hex.DecodeString("34d4a23a1fc0c694f0d74ddaf9d8d564cfe2d430")
+	other, _ := hex.DecodeString("c330431496364497d7257839737b5e4596f5ac06")
+	k.SetParams(ctx, datarelay.NewParams([]datarelay.Sender{datarelay.NewSender(2, sender)}))
+
+	// only the registered senders may store data, and only through the data relay contract hash
+	err := k.StoreData(ctx, 2, other, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{1}), 10)
+	require.True(t, datarelay.ErrSenderNotRegisteredType.Is(err))
+	err = k.StoreData(ctx, 3, sender, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{1}), 10)
+	require.True(t, datarelay.ErrSenderNotRegisteredType.Is(err))
+	err = k.StoreData(ctx, 2, sender, other, storeDataArgs("ETH/USD", []byte{1}), 10)
+	require.True(t, datarelay.ErrStoreDataType.Is(err))
+	err = k.StoreData(ctx, 2, sender, datarelay.ContractHash, storeDataArgs("", []byte{1}), 10)
+	require.True(t, datarelay.ErrStoreDataType.Is(err))
+	err = k.StoreData(ctx, 2, sender, datarelay.ContractHash, []byte{0xff}, 10)
+	require.True(t, datarelay.ErrStoreDataType.Is(err))
+	_, found := k.GetData(ctx, 2, sender, "ETH/USD")
+	require.False(t, found)
+
+	require.Nil(t, k.StoreData(ctx, 2, sender, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{1}), 10))
+	data, found := k.GetData(ctx, 2, sender, "ETH/USD")
+	require.True(t, found)
+	require.Equal(t, datarelay.StoredData{FromChainId: 2, FromContract: sender, Topic: "ETH/USD", Data: []byte{1}, PolyHeight: 10}, data)
+
+	// newer data replaces the stored one, data proven against an older header is acknowledged but dropped
+	require.Nil(t, k.StoreData(ctx, 2, sender, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{2}), 12))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.StoreData(ctx, 2, sender, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{3}), 11))
+	data, _ = k.GetData(ctx, 2, sender, "ETH/USD")
+	require.Equal(t, []byte{2}, data.Data)
+	require.Equal(t, uint32(12), data.PolyHeight)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, datarelay.EventTypeStoreData, events[0].Type)
+	require.Contains(t, events[0].Attributes, kv.Pair{Key: []byte(datarelay.AttributeKeyStored), Value: []byte("false")})
+
+	// topics are namespaced by sender
+	_, found = k.GetData(ctx, 2, other, "ETH/USD")
+	require.False(t, found)
+
+	querier := datarelay.NewQuerier(k)
+	bz, err := querier(ctx, []string{datarelay.QueryData}, abci.RequestQuery{Data: app.Codec().MustMarshalJSON(datarelay.NewQueryDataParam(2, sender, "ETH/USD"))})
+	require.Nil(t, err)
+	var queried datarelay.StoredData
+	app.Codec().MustUnmarshalJSON(bz, &queried)
+	require.Equal(t, data, queried)
+	_, err = querier(ctx, []string{datarelay.QueryData}, abci.RequestQuery{Data: app.Codec().MustMarshalJSON(datarelay.NewQueryDataParam(2, sender, "BTC/USD"))})
+	require.True(t, datarelay.ErrDataNotFoundType.Is(err))
+}
+
+func Test_datarelay_ProcessStoreDataTx(t *testing.T) {
+	app, ctx := createTestApp(false)
+	sender, _ := hex.DecodeString("34d4a23a1fc0c694f0d74ddaf9d8d564cfe2d430")
+	app.DataRelayKeeper.SetParams(ctx, datarelay.NewParams([]datarelay.Sender{datarelay.NewSender(2, sender)}))
+
+	merkleValue := &ccmc.ToMerkleValue{
+		TxHash:      []byte("polyhash"),
+		FromChainID: 2,
+		MakeTxParam: &ccmc.MakeTxParam{
+			TxHash:              []byte("txhash"),
+			CrossChainID:        []byte{1},
+			FromContractAddress: sender,
+			ToChainID:           5,
+			ToContractAddress:   datarelay.ContractHash,
+			Method:              datarelay.MethodStoreData,
+			Args:                storeDataArgs("ETH/USD", []byte("price")),
+		},
+	}
+	require.Nil(t, app.CcmKeeper.ProcessStoreDataTx(ctx, merkleValue, 42))
+	data, found := app.DataRelayKeeper.GetData(ctx, 2, sender, "ETH/USD")
+	require.True(t, found)
+	require.Equal(t, []byte("price"), data.Data)
+	require.Equal(t, uint32(42), data.PolyHeight)
+	require.Equal(t, ccm.MethodStoreData, datarelay.MethodStoreData)
+}
+
+func Test_datarelay_Genesis(t *testing.T) {
+	app, ctx := createTestApp(false)
+	sender, _ := hex.DecodeString("34d4a23a1fc0c694f0d74ddaf9d8d564cfe2d430")
+	other, _ := hex.DecodeString("c330431496364497d7257839737b5e4596f5ac06")
+	app.DataRelayKeeper.SetParams(ctx, datarelay.NewParams([]datarelay.Sender{datarelay.NewSender(2, sender), datarelay.NewSender(3, other)}))
+	require.Nil(t, app.DataRelayKeeper.StoreData(ctx, 2, sender, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{1}), 10))
+	require.Nil(t, app.DataRelayKeeper.StoreData(ctx, 2, sender, datarelay.ContractHash, storeDataArgs("BTC/USD", []byte{2}), 11))
+	require.Nil(t, app.DataRelayKeeper.StoreData(ctx, 3, other, datarelay.ContractHash, storeDataArgs("ETH/USD", []byte{3}), 12))
+
+	exported := datarelay.ExportGenesis(ctx, app.DataRelayKeeper)
+	require.Len(t, exported.StoredData, 3)
+	require.Nil(t, datarelay.ValidateGenesis(exported))
+
+	imported, importedCtx := createTestApp(false)
+	datarelay.InitGenesis(importedCtx, imported.DataRelayKeeper, exported)
+	require.Equal(t, exported, datarelay.ExportGenesis(importedCtx, imported.DataRelayKeeper))
+	data, found := imported.DataRelayKeeper.GetData(importedCtx, 3, other, "ETH/USD")
+	require.True(t, found)
+	require.Equal(t, datarelay.StoredData{FromChainId: 3, FromContract: other, Topic: "ETH/USD", Data: []byte{3}, PolyHeight: 12}, data)
+
+	duplicated := datarelay.NewGenesisState(exported.Params, append(exported.StoredData, exported.StoredData[0]))
+	require.Error(t, datarelay.ValidateGenesis(duplicated))
+	invalid := datarelay.NewGenesisState(exported.Params, []datarelay.StoredData{{FromChainId: 2, FromContract: sender}})
+	require.Error(t, datarelay.ValidateGenesis(invalid))
+}
+
+func Test_datarelay_Publish(t *testing.T) {
+	app, ctx := createTestApp(false)
+	publisher := sdk.AccAddress([]byte("publisher-address-20"))
+	toContract, _ := hex.DecodeString("34d4a23a1fc0c694f0d74ddaf9d8d564cfe2d430")
+
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{
+		ccm.NewChainInfo(3, "neo", ccm.VMFamilyNeoVM, 20, ccm.AddressEncodingRaw, 32, true),
+	})
+	err := app.DataRelayKeeper.Publish(ctx, publisher, 2, toContract, "ETH/USD", []byte("price"))
+	require.True(t, datarelay.ErrPublishType.Is(err), "publishing towards an unregistered chain should fail")
+
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{
+		ccm.NewChainInfo(2, "ethereum", ccm.VMFamilyEVM, 20, ccm.AddressEncodingRaw, 32, true),
+	})
+	handler := datarelay.NewHandler(app.DataRelayKeeper)
+	_, err = handler(ctx, datarelay.NewMsgPublish(publisher, 2, toContract, "ETH/USD", []byte("price")))
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), app.CcmKeeper.GetCrossChainSequence(ctx, 2))
+
+	require.NotEqual(t, []byte(publisher), datarelay.GetPublisherContractHash(publisher))
+	require.Error(t, datarelay.NewMsgPublish(publisher, 2, toContract, "", nil).ValidateBasic())
+	require.Nil(t, datarelay.NewMsgPublish(publisher, 2, toContract, "ETH/USD", nil).ValidateBasic())
+}
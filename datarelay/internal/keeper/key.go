@@ -0,0 +1,35 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+)
+
+var (
+	DataPrefix = []byte{0x01}
+)
+
+// GetDataKey returns the key of the data stored under topic by the contract fromContract of the chain fromChainId
+func GetDataKey(fromChainId uint64, fromContract []byte, topic string) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, fromChainId)
+	key := append(append([]byte{}, DataPrefix...), b...)
+	key = append(append(key, byte(len(fromContract))), fromContract...)
+	return append(key, []byte(topic)...)
+}
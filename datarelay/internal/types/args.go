@@ -0,0 +1,49 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	polycommon "github.com/polynetwork/poly/common"
+)
+
+// StoreDataArgs are the args of MethodStoreData, Data is stored under Topic in the namespace of the sender
+type StoreDataArgs struct {
+	Topic string
+	Data  []byte
+}
+
+func (this *StoreDataArgs) Serialization(sink *polycommon.ZeroCopySink) {
+	sink.WriteString(this.Topic)
+	sink.WriteVarBytes(this.Data)
+}
+
+func (this *StoreDataArgs) Deserialization(source *polycommon.ZeroCopySource) error {
+	topic, eof := source.NextString()
+	if eof {
+		return fmt.Errorf("StoreDataArgs deserialize Topic error")
+	}
+	data, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("StoreDataArgs deserialize Data error")
+	}
+	this.Topic = topic
+	this.Data = data
+	return nil
+}
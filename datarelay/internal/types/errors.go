@@ -0,0 +1,57 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrMsgPublishType          = sdkerrors.Register(ModuleName, 1, "ErrMsgPublishType")
+	ErrPublishType             = sdkerrors.Register(ModuleName, 2, "ErrPublishType")
+	ErrStoreDataType           = sdkerrors.Register(ModuleName, 3, "ErrStoreDataType")
+	ErrSenderNotRegisteredType = sdkerrors.Register(ModuleName, 4, "ErrSenderNotRegisteredType")
+	ErrDataNotFoundType        = sdkerrors.Register(ModuleName, 5, "ErrDataNotFoundType")
+	ErrUnmarshalSpecificType   = sdkerrors.Register(ModuleName, 6, "ErrUnmarshalSpecificType")
+)
+
+func ErrMsgPublish(reason string) error {
+	return sdkerrors.Wrapf(ErrMsgPublishType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrPublish(reason string) error {
+	return sdkerrors.Wrapf(ErrPublishType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrStoreData(reason string) error {
+	return sdkerrors.Wrapf(ErrStoreDataType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrSenderNotRegistered(fromChainId uint64, fromContract []byte) error {
+	return sdkerrors.Wrapf(ErrSenderNotRegisteredType, fmt.Sprintf("Reason: contract: %x of chainId: %d is not a registered sender", fromContract, fromChainId))
+}
+
+func ErrDataNotFound(fromChainId uint64, fromContract []byte, topic string) error {
+	return sdkerrors.Wrapf(ErrDataNotFoundType, fmt.Sprintf("Reason: no data of contract: %x of chainId: %d under topic: %s", fromContract, fromChainId, topic))
+}
+
+func ErrUnmarshalSpecificTypeFail(o interface{}, err error) error {
+	return sdkerrors.Wrap(ErrUnmarshalSpecificType, fmt.Sprintf("Umarshal type: %T, Error: %s", o, err.Error()))
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - datarelay state
+type GenesisState struct {
+	Params     Params       `json:"params" yaml:"params"`
+	StoredData []StoredData `json:"stored_data" yaml:"stored_data"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, storedData []StoredData) GenesisState {
+	return GenesisState{
+		Params:     params,
+		StoredData: storedData,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	stored := make(map[string]bool, len(data.StoredData))
+	for _, d := range data.StoredData {
+		if len(d.FromContract) == 0 {
+			return fmt.Errorf("data of topic: %s from chainId: %d has empty fromContract", d.Topic, d.FromChainId)
+		}
+		if err := ValidateTopic(d.Topic); err != nil {
+			return fmt.Errorf("data from contract: %x of chainId: %d, Error: %s", d.FromContract, d.FromChainId, err.Error())
+		}
+		key := fmt.Sprintf("%d/%x/%s", d.FromChainId, d.FromContract, d.Topic)
+		if stored[key] {
+			return fmt.Errorf("data of topic: %s from contract: %x of chainId: %d is duplicated", d.Topic, d.FromContract, d.FromChainId)
+		}
+		stored[key] = true
+	}
+	return nil
+}
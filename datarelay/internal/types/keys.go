@@ -0,0 +1,60 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "datarelay"
+
+	// default paramspace for params keeper
+	DefaultParamspace = ModuleName
+
+	// StoreKey is the default store key for datarelay
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the datarelay store.
+	QuerierRoute = StoreKey
+
+	// RouterKey is the message route for datarelay
+	RouterKey = ModuleName
+
+	// ConsensusVersion is the version of the store layout, the stores of older versions are migrated on upgrade
+	ConsensusVersion = 1
+)
+
+// MethodStoreData is the cross chain method the data relay application is called with, both by the contracts
+// of other chains towards this chain and by MsgPublish towards other chains
+const MethodStoreData = "storeData"
+
+// MaxTopicLength bounds the length of the topic the data is stored under
+const MaxTopicLength = 128
+
+// ContractHash is the contract hash the cross chain txs towards the data relay application target
+var ContractHash = tmhash.SumTruncated([]byte(ModuleName))
+
+// GetPublisherContractHash returns the FromContractAddress of the cross chain txs created by publisher
+// through MsgPublish, which is what the other chains register as sender. It is domain separated from the
+// plain address bytes so that an account can never pose as a lock proxy or as any other module contract hash
+func GetPublisherContractHash(publisher []byte) []byte {
+	return tmhash.SumTruncated(append([]byte(ModuleName+"/publisher/"), publisher...))
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// datarelay message types and routes
+const (
+	TypeMsgPublish = "publish"
+)
+
+// MsgPublish sends Data under Topic to the contract ToContract of the chain ToChainId
+type MsgPublish struct {
+	Publisher  sdk.AccAddress
+	ToChainId  uint64
+	ToContract []byte
+	Topic      string
+	Data       []byte
+}
+
+func NewMsgPublish(publisher sdk.AccAddress, toChainId uint64, toContract []byte, topic string, data []byte) MsgPublish {
+	return MsgPublish{Publisher: publisher, ToChainId: toChainId, ToContract: toContract, Topic: topic, Data: data}
+}
+
+// nolint
+func (msg MsgPublish) Route() string { return RouterKey }
+func (msg MsgPublish) Type() string  { return TypeMsgPublish }
+
+// Implements Msg.
+func (msg MsgPublish) ValidateBasic() error {
+	if msg.Publisher.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.ToChainId == 0 {
+		return ErrMsgPublish(fmt.Sprintf("MsgPublish.ToChainId should not be zero"))
+	}
+	if len(msg.ToContract) == 0 {
+		return ErrMsgPublish("empty MsgPublish.ToContract")
+	}
+	if err := ValidateTopic(msg.Topic); err != nil {
+		return ErrMsgPublish(err.Error())
+	}
+	return nil
+}
+
+func (msg MsgPublish) String() string {
+	return fmt.Sprintf(`Publish Message:
+  Publisher:       %s
+  ToChainId:       %d
+  ToContract:      %s
+  Topic:           %s
+  Data:            %s
+`, msg.Publisher.String(), msg.ToChainId, hex.EncodeToString(msg.ToContract), msg.Topic, hex.EncodeToString(msg.Data))
+}
+
+// Implements Msg.
+func (msg MsgPublish) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgPublish) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Publisher}
+}
+
+// ValidateTopic checks topic is not empty and not longer than MaxTopicLength
+func ValidateTopic(topic string) error {
+	if topic == "" {
+		return fmt.Errorf("empty topic")
+	}
+	if len(topic) > MaxTopicLength {
+		return fmt.Errorf("topic of length: %d is longer than %d", len(topic), MaxTopicLength)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyRegisteredSenders = []byte("RegisteredSenders")
+)
+
+type Params struct {
+	RegisteredSenders []Sender `json:"registered_senders" yaml:"registered_senders"` // contracts of other chains whose "storeData" calls are accepted
+}
+
+// Sender is one (fromChainId, fromContract) entry of the registered senders,
+// FromContract is the hex format of the source contract hash
+type Sender struct {
+	FromChainId  uint64 `json:"from_chain_id" yaml:"from_chain_id"`
+	FromContract string `json:"from_contract" yaml:"from_contract"`
+}
+
+func NewSender(fromChainId uint64, fromContract []byte) Sender {
+	return Sender{FromChainId: fromChainId, FromContract: hex.EncodeToString(fromContract)}
+}
+
+// Matches returns true if the entry is the contract fromContract of the chain fromChainId
+func (s Sender) Matches(fromChainId uint64, fromContract []byte) bool {
+	return s.FromChainId == fromChainId && strings.EqualFold(s.FromContract, hex.EncodeToString(fromContract))
+}
+
+func (s Sender) String() string {
+	return fmt.Sprintf("%d/%s", s.FromChainId, s.FromContract)
+}
+
+// ParamTable for datarelay module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(registeredSenders []Sender) Params {
+	if registeredSenders == nil {
+		registeredSenders = []Sender{}
+	}
+	return Params{RegisteredSenders: registeredSenders}
+}
+
+// default datarelay module parameters
+func DefaultParams() Params {
+	return Params{
+		RegisteredSenders: []Sender{},
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateRegisteredSenders(p.RegisteredSenders); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateRegisteredSenders(i interface{}) error {
+	v, ok := i.([]Sender)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, sender := range v {
+		if sender.FromChainId <= 0 {
+			return fmt.Errorf("registered sender: %s has invalid fromChainId", sender.String())
+		}
+		fromContract, err := hex.DecodeString(sender.FromContract)
+		if err != nil || len(fromContract) == 0 {
+			return fmt.Errorf("registered sender: %s has invalid hex fromContract", sender.String())
+		}
+		key := strings.ToLower(sender.String())
+		if seen[key] {
+			return fmt.Errorf("registered sender: %s is duplicated", sender.String())
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	senders := make([]string, 0, len(p.RegisteredSenders))
+	for _, sender := range p.RegisteredSenders {
+		senders = append(senders, sender.String())
+	}
+	return fmt.Sprintf(`Datarelay Params:
+  Registered Senders:               %s
+`,
+		strings.Join(senders, ", "),
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyRegisteredSenders, &p.RegisteredSenders, validateRegisteredSenders),
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// StoredData is the latest payload received from the contract FromContract of the chain FromChainId under
+// Topic, PolyHeight is the height of the Poly header the cross chain tx carrying it was proven against
+type StoredData struct {
+	FromChainId  uint64 `json:"from_chain_id" yaml:"from_chain_id"`
+	FromContract []byte `json:"from_contract" yaml:"from_contract"`
+	Topic        string `json:"topic" yaml:"topic"`
+	Data         []byte `json:"data" yaml:"data"`
+	PolyHeight   uint32 `json:"poly_height" yaml:"poly_height"`
+}
+
+func (d StoredData) String() string {
+	return fmt.Sprintf(`
+  FromChainId:        %d
+  FromContract:       %s
+  Topic:              %s
+  Data:               %s
+  PolyHeight:         %d
+`, d.FromChainId, hex.EncodeToString(d.FromContract), d.Topic, hex.EncodeToString(d.Data), d.PolyHeight)
+}
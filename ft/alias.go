@@ -30,6 +30,7 @@ const (
 	QuerierRoute      = types.QuerierRoute
 	QueryParameters   = types.QueryParameters
 	RouterKey         = types.RouterKey
+	ConsensusVersion  = types.ConsensusVersion
 
 	EventTypeCreateCoins   = types.EventTypeCreateCoins
 	AttributeValueCategory = types.AttributeValueCategory
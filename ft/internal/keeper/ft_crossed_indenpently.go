@@ -22,17 +22,16 @@ import (
 	"encoding/hex"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common/telemetry"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 	polycommon "github.com/polynetwork/poly/common"
 	"strconv"
 )
 
 func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom string) error {
-	if reason, exist := k.ccmKeeper.ExistDenom(ctx, denom); exist {
-		return types.ErrCreateDenom(fmt.Sprintf("denom: %s already exist, due to reason: %s", denom, reason))
+	if err := k.ccmKeeper.RegisterDenom(ctx, creator, denom, types.ModuleName, 0, "", 0, nil); err != nil {
+		return types.ErrCreateDenom(fmt.Sprintf("RegisterDenom Error: %s", err.Error()))
 	}
-	//k.SetOperator(ctx, denom, creator)
-	k.ccmKeeper.SetDenomCreator(ctx, denom, creator)
 	ctx.KVStore(k.storeKey).Set(GetIndependentCrossDenomKey(denom), []byte(denom))
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -71,6 +70,12 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAss
 }
 
 func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddr []byte, amount sdk.Int) error {
+	if err := k.ccmKeeper.ValidateToAddress(ctx, toChainId, toAddr); err != nil {
+		return types.ErrLock(fmt.Sprintf("ccmKeeper.ValidateToAddress Error: %s", err.Error()))
+	}
+	if err := k.ccmKeeper.ValidateToAmount(ctx, toChainId, amount); err != nil {
+		return types.ErrLock(fmt.Sprintf("ccmKeeper.ValidateToAmount Error: %s", err.Error()))
+	}
 	sink := polycommon.NewZeroCopySink(nil)
 	args := types.TxArgs{
 		ToAddress: toAddr,
@@ -107,6 +112,7 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	telemetry.RecordLock(ctx, types.ModuleName, sourceAssetDenom, amount)
 	return nil
 }
 
@@ -138,6 +144,7 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	telemetry.RecordUnlock(ctx, types.ModuleName, denom, amount)
 	return nil
 }
 
@@ -23,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/polynetwork/cosmos-poly-module/btcx"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
 	"github.com/polynetwork/cosmos-poly-module/ft"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/keeper"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
@@ -146,6 +147,11 @@ func Test_ft_crossed_independently_MsgLock(t *testing.T) {
 	err = app.FtKeeper.BindAssetHash(ctx, creator, denom, 3, []byte{1, 2, 3, 5})
 	require.Nil(t, err)
 
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{
+		ccm.NewChainInfo(2, "chain2", ccm.VMFamilyEVM, 0, ccm.AddressEncodingRaw, 32, true),
+		ccm.NewChainInfo(3, "chain3", ccm.VMFamilyNeoVM, 0, ccm.AddressEncodingRaw, 32, true),
+	})
+
 	testCases := []struct {
 		from          sdk.AccAddress
 		denom         string
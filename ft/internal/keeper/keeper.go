@@ -76,10 +76,9 @@ func (k Keeper) EnsureAccountExist(ctx sdk.Context, addr sdk.AccAddress) error {
 
 func (k Keeper) CreateCoins(ctx sdk.Context, creator sdk.AccAddress, coins sdk.Coins) error {
 	for _, coin := range coins {
-		if reason, exist := k.ccmKeeper.ExistDenom(ctx, coin.Denom); exist {
-			return types.ErrCreateCoins(fmt.Sprintf("denom:%s already exist, due to reason:%s", coin.Denom, reason))
+		if err := k.ccmKeeper.RegisterDenom(ctx, creator, coin.Denom, types.ModuleName, 0, "", 0, nil); err != nil {
+			return types.ErrCreateCoins(fmt.Sprintf("RegisterDenom Error: %s", err.Error()))
 		}
-		k.ccmKeeper.SetDenomCreator(ctx, coin.Denom, creator)
 	}
 	if err := k.MintCoins(ctx, creator, sdk.NewCoins(coins...)); err != nil {
 		return types.ErrCreateCoins(fmt.Sprintf("MintCoins Error: %s", err.Error()))
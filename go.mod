@@ -5,9 +5,13 @@ go 1.14
 require (
 	github.com/btcsuite/btcutil v1.0.2
 	github.com/cosmos/cosmos-sdk v0.39.1
+	github.com/davecgh/go-spew v1.1.1
+	github.com/go-kit/kit v0.10.0
 	github.com/gorilla/mux v1.7.4
 	github.com/polynetwork/poly v0.0.0-20200710095239-0596a3d7afe5
+	github.com/prometheus/client_golang v1.5.1
 	github.com/spf13/cobra v1.0.0
+	github.com/spf13/viper v1.6.3
 	github.com/stretchr/testify v1.6.1
 	github.com/tendermint/tendermint v0.33.7
 	github.com/tendermint/tm-db v0.5.1
@@ -30,8 +30,10 @@ const (
 	QueryParameters               = types.QueryParameters
 	QueryConsensusPeers           = types.QueryConsensusPeers
 	RouterKey                     = types.RouterKey
+	ConsensusVersion              = types.ConsensusVersion
 	AttributeValueCategory        = types.AttributeValueCategory
 	EventTypeSyncHeader           = types.EventTypeSyncHeader
+	EventTypeUpdateConsensusPeers = types.EventTypeUpdateConsensusPeers
 	AttributeKeyChainId           = types.AttributeKeyChainId
 	AttributeKeyHeight            = types.AttributeKeyHeight
 	AttributeKeyBlockHash         = types.AttributeKeyBlockHash
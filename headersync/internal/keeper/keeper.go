@@ -18,20 +18,18 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/hex"
-	"encoding/json"
-	"errors"
 	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/polynetwork/cosmos-poly-module/common/telemetry"
+	"github.com/polynetwork/cosmos-poly-module/common/verifier"
 	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
 	polycommon "github.com/polynetwork/poly/common"
-	vconfig "github.com/polynetwork/poly/consensus/vbft/config"
-	polysig "github.com/polynetwork/poly/core/signature"
 	polytype "github.com/polynetwork/poly/core/types"
-	"github.com/polynetwork/poly/merkle"
 )
 
 // Keeper of the mint store
@@ -88,88 +86,75 @@ func (keeper Keeper) SyncBlockHeaders(ctx sdk.Context, headerStrs []string) erro
 	return nil
 }
 
-func (keeper Keeper) ProcessHeader(ctx sdk.Context, header *polytype.Header, headerProof []byte, curHeader *polytype.Header) error {
-	// header to be checked if containing valid NewChainConfig
-	var cpHeader *polytype.Header
-	if curHeader == nil || headerProof == nil {
-		if err := keeper.VerifyHeaderSig(ctx, header); err != nil {
-			if err := keeper.VerifyHeaderByKeyHeaderHash(ctx, header); err == nil {
-				return nil
-			}
-			return err
-		}
-		cpHeader = header
-	} else {
-		if err := keeper.VerifyHistoricalHeader(ctx, header, headerProof, curHeader); err != nil {
-			return err
-		}
-		cpHeader = curHeader
+func (keeper Keeper) ProcessHeader(ctx sdk.Context, header *polytype.Header, headerProof []byte, curHeader *polytype.Header) (err error) {
+	defer func() {
+		telemetry.RecordHeader(ctx, header.ChainID, err == nil)
+	}()
+
+	peersHeader := header
+	if curHeader != nil && headerProof != nil {
+		peersHeader = curHeader
+	}
+	consensusPeers, err := keeper.GetConsensusPeers(ctx, peersHeader.ChainID)
+	if err != nil {
+		return types.ErrSyncBlockHeader("GetConsensusPeer", peersHeader.ChainID, peersHeader.Height, err)
 	}
+	// without a key header hash, header can only be accepted by its signatures
+	keyHeaderHash, _ := keeper.GetKeyHeaderHash(ctx, header.ChainID)
 
+	// header to be checked if containing valid NewChainConfig
+	cpHeader, err := verifier.VerifyHeader(header, headerProof, curHeader, consensusPeers, keyHeaderHash)
+	if err != nil {
+		return types.ErrVerifyHeader(err)
+	}
+	if cpHeader == nil {
+		return nil
+	}
 	if err := keeper.UpdateConsensusPeer(ctx, cpHeader); err != nil {
 		return err
 	}
 	return nil
 }
 
+// VerifyHeaderSig verifies header against the stored consensus peers of its chain, see verifier.VerifyHeaderSig
 func (keeper Keeper) VerifyHeaderSig(ctx sdk.Context, header *polytype.Header) error {
 	consensusPeer, err := keeper.GetConsensusPeers(ctx, header.ChainID)
 	if err != nil {
 		return types.ErrSyncBlockHeader("GetConsensusPeer", header.ChainID, header.Height, err)
 	}
-	if header.Height <= consensusPeer.Height {
-		return types.ErrSyncBlockHeader("Compare height", header.ChainID, header.Height, errors.New(fmt.Sprintf("Stored consensus header.Height: %d, trying to sync height:%d", consensusPeer.Height, header.Height)))
-	}
-
-	if len(header.Bookkeepers)*3 < len(consensusPeer.PeerMap)*2 {
-		return types.ErrBookKeeperNum(len(header.Bookkeepers), len(consensusPeer.PeerMap))
-	}
-	for _, bookkeeper := range header.Bookkeepers {
-		pubkey := vconfig.PubkeyID(bookkeeper)
-		_, present := consensusPeer.PeerMap[pubkey]
-		if !present {
-			return types.ErrInvalidPublicKey(pubkey)
-		}
-	}
-	hash := header.Hash()
-	if e := polysig.VerifyMultiSignature(hash[:], header.Bookkeepers, len(header.Bookkeepers), header.SigData); e != nil {
-		return types.ErrVerifyMultiSigFail(err, header.Height)
-	}
-	return nil
+	return types.ErrVerifyHeader(verifier.VerifyHeaderSig(header, consensusPeer))
 }
+
 func (keeper Keeper) VerifyHeaderByKeyHeaderHash(ctx sdk.Context, header *polytype.Header) error {
-	headerHash := header.Hash()
 	keyHeaderHash, err := keeper.GetKeyHeaderHash(ctx, header.ChainID)
 	if err != nil {
 		return fmt.Errorf("VerifyHeaderByKeyHeaderHash, GetKeyHeaderHash Error: %s", err.Error())
 	}
-	if headerHash == *keyHeaderHash {
-		return nil
-	}
-	return fmt.Errorf("VerifyHeaderByKeyHeaderHash, not equal, expect: %s, got: %s", keyHeaderHash.ToArray(), headerHash.ToArray())
+	return verifier.VerifyHeaderByKeyHeaderHash(header, *keyHeaderHash)
 }
 
 func (keeper Keeper) UpdateConsensusPeer(ctx sdk.Context, header *polytype.Header) error {
-
-	blkInfo := &vconfig.VbftBlockInfo{}
-	if err := json.Unmarshal(header.ConsensusPayload, blkInfo); err != nil {
-		return types.ErrUnmarshalSpecificTypeFail(blkInfo, err)
+	consensusPeers, err := verifier.NewConsensusPeers(header)
+	if err != nil {
+		return err
 	}
-	if blkInfo.NewChainConfig != nil {
-		consensusPeers := &types.ConsensusPeers{
-			ChainID: header.ChainID,
-			Height:  header.Height,
-			PeerMap: make(map[string]*types.Peer),
-		}
-		for _, p := range blkInfo.NewChainConfig.Peers {
-			consensusPeers.PeerMap[p.ID] = &types.Peer{Index: p.Index, PeerPubkey: p.ID}
-		}
+	if consensusPeers != nil {
 		if err := keeper.SetConsensusPeers(ctx, *consensusPeers); err != nil {
 			return err
 		}
 		if err := keeper.SetKeyHeaderHash(ctx, consensusPeers.ChainID, header.Hash()); err != nil {
 			return err
 		}
+		telemetry.RecordEpochRotation(ctx, header.ChainID)
+		blockHash := header.Hash()
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateConsensusPeers,
+				sdk.NewAttribute(types.AttributeKeyChainId, strconv.FormatUint(header.ChainID, 10)),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(uint64(header.Height), 10)),
+				sdk.NewAttribute(types.AttributeKeyBlockHash, blockHash.ToHexString()),
+			),
+		)
 	}
 	return nil
 }
@@ -214,17 +199,12 @@ func (keeper Keeper) GetKeyHeaderHash(ctx sdk.Context, chainId uint64) (*polycom
 	return &headerHash, nil
 }
 
+// VerifyHistoricalHeader verifies header through curHeader against the stored consensus peers of its chain,
+// see verifier.VerifyHistoricalHeader
 func (keeper Keeper) VerifyHistoricalHeader(ctx sdk.Context, header *polytype.Header, headerProof []byte, curHeader *polytype.Header) error {
-	if err := keeper.VerifyHeaderSig(ctx, curHeader); err != nil {
-		return err
-	}
-	value, err := merkle.MerkleProve(headerProof, curHeader.BlockRoot[:])
+	consensusPeer, err := keeper.GetConsensusPeers(ctx, curHeader.ChainID)
 	if err != nil {
-		return fmt.Errorf("VerifyHistoricalHeader, MerkleProve error: %s", err.Error())
+		return types.ErrSyncBlockHeader("GetConsensusPeer", curHeader.ChainID, curHeader.Height, err)
 	}
-	hashToBeVerified := header.Hash()
-	if !bytes.Equal(value, hashToBeVerified[:]) {
-		return fmt.Errorf("VerifyHistoricalHeader error, historical header height: %d, current epoch header height: %d, expect: %x, got: %x", header.Height, curHeader.Height, hashToBeVerified[:], value)
-	}
-	return nil
+	return types.ErrVerifyHeader(verifier.VerifyHistoricalHeader(header, headerProof, curHeader, consensusPeer))
 }
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common/verifier"
 	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
 	"github.com/polynetwork/cosmos-poly-module/simapp"
 	polycommon "github.com/polynetwork/poly/common"
@@ -106,6 +107,39 @@ func Test_headersync_SyncHeaders(t *testing.T) {
 	assert.Equal(t, cpBs, resSink.Bytes())
 }
 
+func Test_headersync_ProcessHeader_ErrorCodes(t *testing.T) {
+	app, ctx := createTestApp(false)
+	err := app.HeaderSyncKeeper.SyncGenesisHeader(ctx, header180000)
+	assert.Nil(t, err)
+	assert.Equal(t, types.EventTypeUpdateConsensusPeers, ctx.EventManager().Events()[0].Type)
+
+	parseHeader180005 := func() *polytype.Header {
+		h180005s, _ := hex.DecodeString(header180005)
+		header := new(polytype.Header)
+		assert.Nil(t, header.Deserialization(polycommon.NewZeroCopySource(h180005s)))
+		return header
+	}
+
+	header := parseHeader180005()
+	header.Bookkeepers = header.Bookkeepers[:1]
+	err = app.HeaderSyncKeeper.ProcessHeader(ctx, header, nil, nil)
+	assert.True(t, types.ErrBookKeeperNumErrType.Is(err), err)
+
+	header = parseHeader180005()
+	header.SigData = header.SigData[1:]
+	err = app.HeaderSyncKeeper.ProcessHeader(ctx, header, nil, nil)
+	assert.True(t, types.ErrVerifyMultiSigFailType.Is(err), err)
+
+	err = types.ErrVerifyHeader(verifier.ErrInvalidPublicKey("pubkey"))
+	assert.True(t, types.ErrInvalidPublicKeyType.Is(err), err)
+
+	// a header of the current epoch is accepted without switching the consensus peers
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = app.HeaderSyncKeeper.ProcessHeader(ctx, parseHeader180005(), nil, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, ctx.EventManager().Events())
+}
+
 func ExtractChainConfig(header *polytype.Header) ([]byte, error) {
 	blkInfo := &vconfig.VbftBlockInfo{}
 	if err := json.Unmarshal(header.ConsensusPayload, blkInfo); err != nil {
@@ -21,6 +21,7 @@ package types
 import (
 	"fmt"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polynetwork/cosmos-poly-module/common/verifier"
 	"reflect"
 )
 
@@ -71,16 +72,20 @@ func ErrGetConsensusPeers(chainId uint64) error {
 	return sdkerrors.Wrap(ErrGetConsensusPeersFailType, fmt.Sprintf("For chainId: %d, Get consensus peers empty error", chainId))
 }
 
-func ErrBookKeeperNum(headerBookKeeperNum int, consensusNodeNum int) error {
-	return sdkerrors.Wrap(ErrBookKeeperNumErrType, fmt.Sprintf("Header Bookkeepers number: %d must more than 2/3 consensus node number: %d", headerBookKeeperNum, consensusNodeNum))
-}
-
-func ErrInvalidPublicKey(pubkey string) error {
-	return sdkerrors.Wrap(ErrInvalidPublicKeyType, fmt.Sprintf("Invalid pubkey: %s", pubkey))
-}
-
-func ErrVerifyMultiSigFail(err error, height uint32) error {
-	return sdkerrors.Wrap(ErrVerifyMultiSigFailType, fmt.Sprintf("Verify multi signature Error: %s of height: %d", err.Error(), height))
+// ErrVerifyHeader keeps the bookkeeper, public key and multi signature errors of the header verifier under the
+// codes this module has always returned them with, any other error is returned as is
+func ErrVerifyHeader(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case verifier.ErrBookKeeperNumType.Is(err):
+		return sdkerrors.Wrap(ErrBookKeeperNumErrType, err.Error())
+	case verifier.ErrInvalidPublicKeyType.Is(err):
+		return sdkerrors.Wrap(ErrInvalidPublicKeyType, err.Error())
+	case verifier.ErrVerifyMultiSigType.Is(err):
+		return sdkerrors.Wrap(ErrVerifyMultiSigFailType, err.Error())
+	}
+	return err
 }
 
 func ErrSyncGenesisHeader(reason string) error {
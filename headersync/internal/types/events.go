@@ -22,6 +22,7 @@ const (
 	AttributeValueCategory = ModuleName
 
 	EventTypeSyncHeader           = "sync_header"
+	EventTypeUpdateConsensusPeers = "update_consensus_peers" // a header switched the consensus peers of its chain to a new epoch
 	AttributeKeyChainId           = "chain_id"
 	AttributeKeyHeight            = "height"
 	AttributeKeyBlockHash         = "block_hash"
@@ -30,6 +30,7 @@ const (
 	QuerierRoute                          = types.QuerierRoute
 	QueryParameters                       = types.QueryParameters
 	RouterKey                             = types.RouterKey
+	ConsensusVersion                      = types.ConsensusVersion
 	AttributeValueCategory                = types.AttributeValueCategory
 	EventTypeCreateLockProxy              = types.EventTypeCreateLockProxy
 	EventTypeCreateAndDelegateCoinToProxy = types.EventTypeCreateAndDelegateCoinToProxy
@@ -47,6 +48,32 @@ const (
 	AttributeKeyFromAddress               = types.AttributeKeyFromAddress
 	AttributeKeyToAddress                 = types.AttributeKeyToAddress
 	AttributeKeyAmount                    = types.AttributeKeyAmount
+	UnassignedChainId                     = types.UnassignedChainId
+	EventTypePendingBindProxy             = types.EventTypePendingBindProxy
+	EventTypePendingBindAsset             = types.EventTypePendingBindAsset
+	EventTypeCancelPendingBindProxy       = types.EventTypeCancelPendingBindProxy
+	EventTypeCancelPendingBindAsset       = types.EventTypeCancelPendingBindAsset
+	EventTypeUnbindProxy                  = types.EventTypeUnbindProxy
+	EventTypeUnbindAsset                  = types.EventTypeUnbindAsset
+	AttributeKeyEffectiveHeight           = types.AttributeKeyEffectiveHeight
+	EventTypeTransferLockProxyOwnership   = types.EventTypeTransferLockProxyOwnership
+	EventTypeAcceptLockProxyOwnership     = types.EventTypeAcceptLockProxyOwnership
+	AttributeKeyOwner                     = types.AttributeKeyOwner
+	AttributeKeyNewOwner                  = types.AttributeKeyNewOwner
+	AttributeKeyPreviousOwner             = types.AttributeKeyPreviousOwner
+	QueryLockProxy                        = types.QueryLockProxy
+	AttributeKeySalt                      = types.AttributeKeySalt
+	MaxLockProxySaltLength                = types.MaxLockProxySaltLength
+	DefaultQueryLimit                     = types.DefaultQueryLimit
+	MaxAssetDecimals                      = types.MaxAssetDecimals
+	AttributeKeySourceDecimals            = types.AttributeKeySourceDecimals
+	AttributeKeyToDecimals                = types.AttributeKeyToDecimals
+	AttributeKeyToAmount                  = types.AttributeKeyToAmount
+	AttributeKeyDust                      = types.AttributeKeyDust
+	AttributeKeyFromAmount                = types.AttributeKeyFromAmount
+	EventTypeUpdateLockLimits             = types.EventTypeUpdateLockLimits
+	AttributeKeyMinAmount                 = types.AttributeKeyMinAmount
+	AttributeKeyMaxAmount                 = types.AttributeKeyMaxAmount
 )
 
 var (
@@ -54,6 +81,7 @@ var (
 	NewKeeper                          = keeper.NewKeeper
 	NewQuerier                         = keeper.NewQuerier
 	ModuleCdc                          = types.ModuleCdc
+	UnassignedProxyHash                = types.UnassignedProxyHash
 	NewMsgCreateLockProxy              = types.NewMsgCreateLockProxy
 	NewMsgCreateCoinAndDelegateToProxy = types.NewMsgCreateCoinAndDelegateToProxy
 	NewMsgBindAssetHash                = types.NewMsgBindAssetHash
@@ -74,14 +102,94 @@ var (
 	BindProxyPrefix                    = keeper.BindProxyPrefix
 	BindAssetPrefix                    = keeper.BindAssetPrefix
 	GetOperatorToLockProxyKey          = keeper.GetOperatorToLockProxyKey
+	GetOperatorToLockProxiesPrefix     = keeper.GetOperatorToLockProxiesPrefix
+	GetLockProxyHash                   = types.GetLockProxyHash
 	GetBindProxyKey                    = keeper.GetBindProxyKey
 	GetBindAssetHashKey                = keeper.GetBindAssetHashKey
+	SplitBindProxyKey                  = keeper.SplitBindProxyKey
+	SplitBindAssetHashKey              = keeper.SplitBindAssetHashKey
+	NewGenesisState                    = types.NewGenesisState
+	DefaultGenesisState                = types.DefaultGenesisState
+	ValidateGenesis                    = types.ValidateGenesis
 	QueryProxyByOperator               = types.QueryProxyByOperator
 	QueryProxyHash                     = types.QueryProxyHash
 	QueryAssetHash                     = types.QueryAssetHash
 	NewQueryProxyByOperatorParam       = types.NewQueryProxyByOperatorParam
 	NewQueryProxyHashParam             = types.NewQueryProxyHashParam
 	NewQueryAssetHashParam             = types.NewQueryAssetHashParam
+	QueryLockedBalance                 = types.QueryLockedBalance
+	NewQueryLockedBalanceParam         = types.NewQueryLockedBalanceParam
+	NewLockedBalance                   = types.NewLockedBalance
+	SumLockedBalances                  = types.SumLockedBalances
+	CoinsEqual                         = types.CoinsEqual
+	LockedBalancePrefix                = keeper.LockedBalancePrefix
+	GetLockedBalanceKey                = keeper.GetLockedBalanceKey
+	SplitLockedBalanceKey              = keeper.SplitLockedBalanceKey
+	RegisterInvariants                 = keeper.RegisterInvariants
+	LockedBalanceInvariant             = keeper.LockedBalanceInvariant
+	ErrLockedBalanceType               = types.ErrLockedBalanceType
+	ErrUnbind                          = types.ErrUnbind
+	ErrCancelPendingBinding            = types.ErrCancelPendingBinding
+	ErrUnbindType                      = types.ErrUnbindType
+	ErrCancelPendingBindingType        = types.ErrCancelPendingBindingType
+	NewMsgUnbindProxyHash              = types.NewMsgUnbindProxyHash
+	NewMsgUnbindAssetHash              = types.NewMsgUnbindAssetHash
+	NewMsgCancelPendingProxyBinding    = types.NewMsgCancelPendingProxyBinding
+	NewMsgCancelPendingAssetBinding    = types.NewMsgCancelPendingAssetBinding
+	ParamKeyTable                      = types.ParamKeyTable
+	NewParams                          = types.NewParams
+	DefaultParams                      = types.DefaultParams
+	KeyBindDelayBlocks                 = types.KeyBindDelayBlocks
+	NewPendingProxyBinding             = types.NewPendingProxyBinding
+	NewPendingAssetBinding             = types.NewPendingAssetBinding
+	QueryPendingBindings               = types.QueryPendingBindings
+	NewQueryPendingBindingsParam       = types.NewQueryPendingBindingsParam
+	PendingProxyBindingPrefix          = keeper.PendingProxyBindingPrefix
+	PendingAssetBindingPrefix          = keeper.PendingAssetBindingPrefix
+	PendingBindingQueuePrefix          = keeper.PendingBindingQueuePrefix
+	GetPendingProxyBindingKey          = keeper.GetPendingProxyBindingKey
+	GetPendingAssetBindingKey          = keeper.GetPendingAssetBindingKey
+	GetPendingBindingQueueKey          = keeper.GetPendingBindingQueueKey
+	ErrLockProxyOwnership              = types.ErrLockProxyOwnership
+	ErrLockProxyOwnershipType          = types.ErrLockProxyOwnershipType
+	NewMsgTransferLockProxyOwnership   = types.NewMsgTransferLockProxyOwnership
+	NewMsgAcceptLockProxyOwnership     = types.NewMsgAcceptLockProxyOwnership
+	NewQueryLockProxyParam             = types.NewQueryLockProxyParam
+	LockProxyOwnerPrefix               = keeper.LockProxyOwnerPrefix
+	LockProxyPendingOwnerPrefix        = keeper.LockProxyPendingOwnerPrefix
+	GetLockProxyOwnerKey               = keeper.GetLockProxyOwnerKey
+	GetLockProxyPendingOwnerKey        = keeper.GetLockProxyPendingOwnerKey
+	QueryProxyBalance                  = types.QueryProxyBalance
+	NewQueryProxyBalanceParam          = types.NewQueryProxyBalanceParam
+	NewProxyBalance                    = types.NewProxyBalance
+	SumProxyBalances                   = types.SumProxyBalances
+	ProxyBalancePrefix                 = keeper.ProxyBalancePrefix
+	GetProxyBalanceKey                 = keeper.GetProxyBalanceKey
+	GetProxyBalancesPrefix             = keeper.GetProxyBalancesPrefix
+	SplitProxyBalanceKey               = keeper.SplitProxyBalanceKey
+	ProxyBalanceInvariant              = keeper.ProxyBalanceInvariant
+	QueryLockProxies                   = types.QueryLockProxies
+	QueryProxyBindings                 = types.QueryProxyBindings
+	QueryAssetBindings                 = types.QueryAssetBindings
+	NewQueryLockProxiesParam           = types.NewQueryLockProxiesParam
+	NewQueryProxyBindingsParam         = types.NewQueryProxyBindingsParam
+	NewQueryAssetBindingsParam         = types.NewQueryAssetBindingsParam
+	GetProxyBindingsPrefix             = keeper.GetProxyBindingsPrefix
+	GetAssetBindingsPrefix             = keeper.GetAssetBindingsPrefix
+	NewAssetDecimals                   = types.NewAssetDecimals
+	AssetDecimalsPrefix                = keeper.AssetDecimalsPrefix
+	GetAssetDecimalsKey                = keeper.GetAssetDecimalsKey
+	NewLockLimits                      = types.NewLockLimits
+	LockLimitsPrefix                   = keeper.LockLimitsPrefix
+	GetLockLimitsKey                   = keeper.GetLockLimitsKey
+	NewMsgUpdateLockLimits             = types.NewMsgUpdateLockLimits
+	ErrLockLimits                      = types.ErrLockLimits
+	ErrLockLimitsType                  = types.ErrLockLimitsType
+	NewUnassignedClaimant              = types.NewUnassignedClaimant
+	UnassignedClaimantPrefix           = keeper.UnassignedClaimantPrefix
+	GetUnassignedClaimantKey           = keeper.GetUnassignedClaimantKey
+	GetUnassignedClaimantsPrefix       = keeper.GetUnassignedClaimantsPrefix
+	SplitUnassignedClaimantKey         = keeper.SplitUnassignedClaimantKey
 )
 
 type (
@@ -93,4 +201,25 @@ type (
 	MsgLock                         = types.MsgLock
 	TxArgs                          = types.TxArgs
 	UnlockKeeper                    = exported.UnlockKeeper
+	GenesisState                    = types.GenesisState
+	LockProxy                       = types.LockProxy
+	ProxyBinding                    = types.ProxyBinding
+	AssetBinding                    = types.AssetBinding
+	LockedBalance                   = types.LockedBalance
+	ProxyBalance                    = types.ProxyBalance
+	AssetDecimals                   = types.AssetDecimals
+	MsgUnbindProxyHash              = types.MsgUnbindProxyHash
+	MsgUnbindAssetHash              = types.MsgUnbindAssetHash
+	MsgCancelPendingProxyBinding    = types.MsgCancelPendingProxyBinding
+	MsgCancelPendingAssetBinding    = types.MsgCancelPendingAssetBinding
+	Params                          = types.Params
+	PendingProxyBinding             = types.PendingProxyBinding
+	PendingAssetBinding             = types.PendingAssetBinding
+	PendingBindings                 = types.PendingBindings
+	MsgTransferLockProxyOwnership   = types.MsgTransferLockProxyOwnership
+	MsgAcceptLockProxyOwnership     = types.MsgAcceptLockProxyOwnership
+	QueryLockProxyParam             = types.QueryLockProxyParam
+	LockLimits                      = types.LockLimits
+	MsgUpdateLockLimits             = types.MsgUpdateLockLimits
+	UnassignedClaimant              = types.UnassignedClaimant
 )
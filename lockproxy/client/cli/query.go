@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"encoding/hex"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -51,6 +52,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryProxyByOperator(queryRoute, cdc),
 			GetCmdQueryProxyHash(queryRoute, cdc),
 			GetCmdQueryAssetHash(queryRoute, cdc),
+			GetCmdQueryLockedBalance(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdQueryPendingBindings(queryRoute, cdc),
+			GetCmdQueryLockProxy(queryRoute, cdc),
+			GetCmdQueryProxyBalance(queryRoute, cdc),
+			GetCmdQueryLockProxies(queryRoute, cdc),
+			GetCmdQueryProxyBindings(queryRoute, cdc),
+			GetCmdQueryAssetBindings(queryRoute, cdc),
 		)...,
 	)
 
@@ -60,14 +69,15 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 // GetCmdQueryValidatorOutstandingRewards implements the query validator outstanding rewards command.
 func GetCmdQueryProxyByOperator(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "proxy-hash-by-operator [operator_address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query lockproxy hex string by the operator/creator",
+		Use:     "lock-proxies-by-operator [operator_address]",
+		Aliases: []string{"proxy-hash-by-operator"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the lock proxies operated by the operator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the created lock proxy contract hash created by the operator
+			fmt.Sprintf(`Query the lock proxy contracts operated by the operator, created by it or transferred to it
 
 Example:
-$ %s query %s proxy-hash-by-operator cosmos1c0n2e6kuzp03pqm3av9q2v0fqn6ql3z5c5ddw7
+$ %s query %s lock-proxies-by-operator cosmos1c0n2e6kuzp03pqm3av9q2v0fqn6ql3z5c5ddw7
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -84,10 +94,9 @@ $ %s query %s proxy-hash-by-operator cosmos1c0n2e6kuzp03pqm3av9q2v0fqn6ql3z5c5dd
 			if err != nil {
 				return err
 			}
-			var proxyHash []byte
-			cdc.MustUnmarshalJSON(res, &proxyHash)
-			fmt.Printf("creator:%s with lock proxy hash:%x \n", operatorAddr, proxyHash)
-			return nil
+			var proxies []types.LockProxy
+			cdc.MustUnmarshalJSON(res, &proxies)
+			return cliCtx.PrintOutput(proxies)
 		},
 	}
 }
@@ -145,17 +154,17 @@ $ %s query %s proxy-hash cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf 2
 func GetCmdQueryAssetHash(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "asset-hash [lock_proxy_hash/operator] [sourceassetdenom] [chainId]",
-		Short: "Query the asset hash in chainId chain corresponding with soureAssetDenom",
+		Short: "Query the asset hash in chainId chain corresponding with soureAssetDenom, with its decimals and lock limits",
 		Args:  cobra.ExactArgs(3),
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the currently synced height of chainId blockchain
+			fmt.Sprintf(`Query the asset hash in chainId chain bound to sourceAssetDenom, with its decimals and lock limits
 
 Example:
-$ %s query %s proxy-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 stake 2
+$ %s query %s asset-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 stake 2
 Or
-$ %s query %s proxy-hash cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf stake 2
+$ %s query %s asset-hash cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf stake 2
 `,
-				version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -181,11 +190,274 @@ $ %s query %s proxy-hash cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf stake 2
 			if err != nil {
 				return err
 			}
-			var assetHash []byte
-			cdc.MustUnmarshalJSON(res, &assetHash)
-			fmt.Printf("asset_hash: %s\n", hex.EncodeToString(assetHash))
-			//return cliCtx.PrintOutput(hex.EncodeToString(proxyHash))
+			var binding types.AssetBinding
+			cdc.MustUnmarshalJSON(res, &binding)
+			return cliCtx.PrintOutput(binding)
+		},
+	}
+}
+
+func GetCmdQueryLockedBalance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "locked-balance [denom] [chainId]",
+		Short: "Query the amount of denom escrowed by the lock proxies for chainId",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of denom locked towards chainId and not unlocked back yet, chainId 0 stands for
+the escrowed coins locked for no chain, which back the unlocks from any chain
+
+Example:
+$ %s query %s locked-balance stake 2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			chainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryLockedBalance(cliCtx, queryRoute, args[0], chainId)
+			if err != nil {
+				return err
+			}
+			var balance types.LockedBalance
+			cdc.MustUnmarshalJSON(res, &balance)
+			return cliCtx.PrintOutput(balance)
+		},
+	}
+}
+
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameters",
+		Args:  cobra.NoArgs,
+		Short: "Query the parameters of lockproxy module, including the bind delay",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s parameters
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryParams(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+			fmt.Printf("Paramters res is:\n %s\n", params.String())
 			return nil
 		},
 	}
 }
+
+func GetCmdQueryPendingBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-bindings [lock_proxy_hash/operator]",
+		Short: "Query the proxy and asset bindings of a lock proxy waiting for the bind delay",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proxy and asset bindings of a lock proxy which take effect at the end of their
+effective height, unless the operator cancels or unbinds them before
+
+Example:
+$ %s query %s pending-bindings e931a4f7020caaacf3ce942567625ebbc0a0ab35
+Or
+$ %s query %s pending-bindings cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				lockProxyBs, err1 := hex.DecodeString(args[0])
+				if err1 != nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s or operator decord Error: %s", err, err1))
+				}
+				lockProxy = append(lockProxy, lockProxyBs...)
+			}
+			res, err := common.QueryPendingBindings(cliCtx, queryRoute, lockProxy)
+			if err != nil {
+				return err
+			}
+			var bindings types.PendingBindings
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+}
+
+func GetCmdQueryLockProxy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock-proxy [lock_proxy_hash]",
+		Short: "Query the current owner and the pending owner of a lock proxy",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current owner of a lock proxy and the new owner it is being transferred to, if any
+
+Example:
+$ %s query %s lock-proxy e931a4f7020caaacf3ce942567625ebbc0a0ab35
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s decode Error: %s", args[0], err))
+			}
+			res, err := common.QueryLockProxy(cliCtx, queryRoute, lockProxy)
+			if err != nil {
+				return err
+			}
+			var proxy types.LockProxy
+			cdc.MustUnmarshalJSON(res, &proxy)
+			return cliCtx.PrintOutput(proxy)
+		},
+	}
+}
+
+func GetCmdQueryProxyBalance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proxy-balance [lock_proxy_hash]",
+		Short: "Query the coins escrowed by a lock proxy",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins escrowed by a lock proxy, which are all the lock proxy may unlock
+
+Example:
+$ %s query %s proxy-balance e931a4f7020caaacf3ce942567625ebbc0a0ab35
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s decode Error: %s", args[0], err))
+			}
+			res, err := common.QueryProxyBalance(cliCtx, queryRoute, lockProxy)
+			if err != nil {
+				return err
+			}
+			var coins sdk.Coins
+			cdc.MustUnmarshalJSON(res, &coins)
+			return cliCtx.PrintOutput(coins)
+		},
+	}
+}
+
+func GetCmdQueryLockProxies(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-proxies",
+		Short: "Query all the lock proxies, page by page",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the created lock proxies with their owners, ordered by hash
+
+Example:
+$ %s query %s lock-proxies --page=2 --limit=100
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := common.QueryLockProxies(cliCtx, queryRoute, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+			var proxies []types.LockProxy
+			cdc.MustUnmarshalJSON(res, &proxies)
+			return cliCtx.PrintOutput(proxies)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of lock proxies to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of lock proxies to query for")
+	return cmd
+}
+
+func GetCmdQueryProxyBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy-bindings [lock_proxy_hash]",
+		Short: "Query the chains a lock proxy is bound to, page by page",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lock proxies of the other chains a lock proxy is bound to, ordered by chainId
+
+Example:
+$ %s query %s proxy-bindings e931a4f7020caaacf3ce942567625ebbc0a0ab35 --page=1 --limit=100
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s decode Error: %s", args[0], err))
+			}
+			res, err := common.QueryProxyBindings(cliCtx, queryRoute, lockProxy, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+			var bindings []types.ProxyBinding
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of proxy bindings to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of proxy bindings to query for")
+	return cmd
+}
+
+func GetCmdQueryAssetBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-bindings [lock_proxy_hash] [sourceassetdenom]",
+		Short: "Query the assets of the other chains the denoms of a lock proxy are bound to, page by page",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the assets of the other chains sourceassetdenom is bound to by a lock proxy, ordered by chainId,
+or the asset bindings of all the denoms of the lock proxy, ordered by denom and chainId, when no denom is given
+
+Example:
+$ %s query %s asset-bindings e931a4f7020caaacf3ce942567625ebbc0a0ab35 stake --page=1 --limit=100
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s decode Error: %s", args[0], err))
+			}
+			var denom string
+			if len(args) > 1 {
+				denom = args[1]
+			}
+			res, err := common.QueryAssetBindings(cliCtx, queryRoute, lockProxy, denom, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+			var bindings []types.AssetBinding
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of asset bindings to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "pagination limit of asset bindings to query for")
+	return cmd
+}
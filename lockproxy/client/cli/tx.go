@@ -53,29 +53,36 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		SendBindProxyHashTxCmd(cdc),
 		SendBindAssetHashTxCmd(cdc),
 		SendLockTxCmd(cdc),
+		SendUnbindProxyHashTxCmd(cdc),
+		SendUnbindAssetHashTxCmd(cdc),
+		SendCancelPendingProxyBindingTxCmd(cdc),
+		SendCancelPendingAssetBindingTxCmd(cdc),
+		SendTransferLockProxyOwnershipTxCmd(cdc),
+		SendAcceptLockProxyOwnershipTxCmd(cdc),
+		SendUpdateLockLimitsTxCmd(cdc),
 	)...)
 	return txCmd
 }
 
 func SendCreateLockProxyTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-lock-proxy [creator]",
-		Short: "Create lockproxy contract by creator, needs creator's signature",
+		Use:   "create-lock-proxy [creator] [salt]",
+		Short: "Create lockproxy contract by creator, whose hash is derived from creator and salt, needs creator's signature",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Example:
-$ %s tx %s create-lock-proxy cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf 
+$ %s tx %s create-lock-proxy cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf usdt
 `,
 				version.ClientName, types.ModuleName,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
 
-			msg := types.NewMsgCreateLockProxy(cliCtx.GetFromAddress())
+			msg := types.NewMsgCreateLockProxy(cliCtx.GetFromAddress(), args[1])
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -129,14 +136,14 @@ $ $ %s tx %s create-coin-delegate cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9
 
 func SendBindProxyHashTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bind-proxy-hash [to_chain_id] [to_chain_proxy_hash]",
+		Use:   "bind-proxy-hash [lock_proxy_hash] [to_chain_id] [to_chain_proxy_hash]",
 		Short: "bindproxyhash by the operator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Example:
-$ %s tx %s bind-proxy-hash 3 11223344556677889900
+$ %s tx %s bind-proxy-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 3 11223344556677889900
 Or
-$ %s tx %s bind-proxy-hash 3 0x11223344556677889900
+$ %s tx %s bind-proxy-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 3 0x11223344556677889900
 `,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 			),
@@ -146,8 +153,12 @@ $ %s tx %s bind-proxy-hash 3 0x11223344556677889900
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			toChainIdStr := args[0]
-			toChainProxyHashStr := args[1]
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+			toChainIdStr := args[1]
+			toChainProxyHashStr := args[2]
 
 			targetChainId, err := strconv.ParseUint(toChainIdStr, 10, 64)
 			if err != nil {
@@ -162,7 +173,7 @@ $ %s tx %s bind-proxy-hash 3 0x11223344556677889900
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgBindProxyHash(cliCtx.GetFromAddress(), targetChainId, toChainProxyHash)
+			msg := types.NewMsgBindProxyHash(cliCtx.GetFromAddress(), lockProxyHash, targetChainId, toChainProxyHash)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -171,30 +182,40 @@ $ %s tx %s bind-proxy-hash 3 0x11223344556677889900
 
 func SendBindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bind-asset-hash [source_asset_denom] [to_chainId] [to_asset_hash]",
-		Short: "bind asset hash by the operator, ",
+		Use:   "bind-asset-hash [lock_proxy_hash] [source_asset_denom] [to_chainId] [to_asset_hash] [source_decimals] [to_decimals]",
+		Short: "bind asset hash by the operator, the amounts crossing the chains are scaled from source_decimals to to_decimals if given",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Example:
-$ %s tx %s bind-asset-hash ont 3 00000000000000000001 100000
+$ %s tx %s bind-asset-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 ont 3 00000000000000000001
+$ %s tx %s bind-asset-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 uusdt 2 dac17f958d2ee523a2206206994597c13d831ec7 6 18
 `,
-				version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 			),
 		),
-		Args: cobra.ExactArgs(4),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 4 && len(args) != 6 {
+				return fmt.Errorf("accepts 4 args, or 6 args with the decimals, received %d", len(args))
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			sourceAssetDenom := args[0]
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+			sourceAssetDenom := args[1]
 
-			toChainIdStr := args[1]
+			toChainIdStr := args[2]
 			toChainId, err := strconv.ParseUint(toChainIdStr, 10, 64)
 			if err != nil {
 				return err
 			}
 
-			toAssetHashStr := args[2]
+			toAssetHashStr := args[3]
 			if toAssetHashStr[0:2] == "0x" {
 				toAssetHashStr = toAssetHashStr[2:]
 			}
@@ -203,8 +224,21 @@ $ %s tx %s bind-asset-hash ont 3 00000000000000000001 100000
 				return fmt.Errorf("decode hex string 'targetProxyHash' error:%v", err)
 			}
 
+			var decimals types.AssetDecimals
+			if len(args) == 6 {
+				sourceDecimals, err := strconv.ParseUint(args[4], 10, 32)
+				if err != nil {
+					return err
+				}
+				toDecimals, err := strconv.ParseUint(args[5], 10, 32)
+				if err != nil {
+					return err
+				}
+				decimals = types.NewAssetDecimals(uint32(sourceDecimals), uint32(toDecimals))
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAssetHash)
+			msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), lockProxyHash, sourceAssetDenom, toChainId, toAssetHash, decimals)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -261,3 +295,256 @@ $ %s tx %s lock 12341234 ont 3 616f2a4a38396ff203ea01e6c070ae421bb8ce2d 123
 	}
 	return cmd
 }
+
+func SendUnbindProxyHashTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind-proxy-hash [lock_proxy_hash] [to_chain_id]",
+		Short: "unbind the proxy hash of to_chain_id at once, together with its pending rebinding, by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s unbind-proxy-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+
+			toChainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUnbindProxyHash(cliCtx.GetFromAddress(), lockProxyHash, toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendUnbindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind-asset-hash [lock_proxy_hash] [source_asset_denom] [to_chain_id]",
+		Short: "unbind the asset hash of source_asset_denom on to_chain_id at once, together with its pending rebinding, by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s unbind-asset-hash e931a4f7020caaacf3ce942567625ebbc0a0ab35 ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+			sourceAssetDenom := args[1]
+
+			toChainId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUnbindAssetHash(cliCtx.GetFromAddress(), lockProxyHash, sourceAssetDenom, toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendCancelPendingProxyBindingTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-pending-proxy-binding [lock_proxy_hash] [to_chain_id]",
+		Short: "cancel the proxy binding to to_chain_id waiting for the bind delay, by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s cancel-pending-proxy-binding e931a4f7020caaacf3ce942567625ebbc0a0ab35 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+
+			toChainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCancelPendingProxyBinding(cliCtx.GetFromAddress(), lockProxyHash, toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendCancelPendingAssetBindingTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-pending-asset-binding [lock_proxy_hash] [source_asset_denom] [to_chain_id]",
+		Short: "cancel the asset binding of source_asset_denom to to_chain_id waiting for the bind delay, by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s cancel-pending-asset-binding e931a4f7020caaacf3ce942567625ebbc0a0ab35 ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+			sourceAssetDenom := args[1]
+
+			toChainId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCancelPendingAssetBinding(cliCtx.GetFromAddress(), lockProxyHash, sourceAssetDenom, toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendTransferLockProxyOwnershipTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock-proxy-ownership [lock_proxy_hash] [new_owner]",
+		Short: "propose new_owner as the operator of the lock proxy, by the current operator. new_owner needs to accept it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Proposing the current operator itself cancels the pending transfer
+
+Example:
+$ %s tx %s transfer-lock-proxy-ownership e931a4f7020caaacf3ce942567625ebbc0a0ab35 cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgTransferLockProxyOwnership(cliCtx.GetFromAddress(), lockProxyHash, newOwner)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendAcceptLockProxyOwnershipTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-lock-proxy-ownership [lock_proxy_hash]",
+		Short: "become the operator of the lock proxy being transferred to the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s accept-lock-proxy-ownership e931a4f7020caaacf3ce942567625ebbc0a0ab35
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgAcceptLockProxyOwnership(cliCtx.GetFromAddress(), lockProxyHash)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendUpdateLockLimitsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-lock-limits [lock_proxy_hash] [source_asset_denom] [to_chain_id] [min_amount] [max_amount]",
+		Short: "bound the amount of source_asset_denom a single lock sends to to_chain_id, zero leaves a side unbounded, by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s update-lock-limits e931a4f7020caaacf3ce942567625ebbc0a0ab35 ont 3 1000 1000000000
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("decode hex string 'lock_proxy_hash' error:%v", err)
+			}
+			sourceAssetDenom := args[1]
+
+			toChainId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			minAmount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("read min_amount as big int from args[3] failed")
+			}
+			maxAmount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("read max_amount as big int from args[4] failed")
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUpdateLockLimits(cliCtx.GetFromAddress(), lockProxyHash, sourceAssetDenom, toChainId, types.NewLockLimits(minAmount, maxAmount))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
@@ -53,3 +53,72 @@ func QueryAssetHash(cliCtx context.CLIContext, queryRoute string, lockProxy []by
 	)
 	return res, err
 }
+
+func QueryLockedBalance(cliCtx context.CLIContext, queryRoute string, denom string, chainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLockedBalance),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryLockedBalanceParam(denom, chainId)),
+	)
+	return res, err
+}
+
+func QueryParams(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters), nil)
+	return res, err
+}
+
+func QueryPendingBindings(cliCtx context.CLIContext, queryRoute string, lockProxy []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryPendingBindingsParam(lockProxy)),
+	)
+	return res, err
+}
+
+func QueryLockProxy(cliCtx context.CLIContext, queryRoute string, lockProxy []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLockProxy),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryLockProxyParam(lockProxy)),
+	)
+	return res, err
+}
+
+func QueryProxyBalance(cliCtx context.CLIContext, queryRoute string, lockProxy []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProxyBalance),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryProxyBalanceParam(lockProxy)),
+	)
+	return res, err
+}
+
+func QueryLockProxies(cliCtx context.CLIContext, queryRoute string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLockProxies),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryLockProxiesParam(page, limit)),
+	)
+	return res, err
+}
+
+func QueryProxyBindings(cliCtx context.CLIContext, queryRoute string, lockProxy []byte, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProxyBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryProxyBindingsParam(lockProxy, page, limit)),
+	)
+	return res, err
+}
+
+func QueryAssetBindings(cliCtx context.CLIContext, queryRoute string, lockProxy []byte, sourceAssetDenom string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAssetBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryAssetBindingsParam(lockProxy, sourceAssetDenom, page, limit)),
+	)
+	return res, err
+}
@@ -30,6 +30,7 @@ const (
 	ToChainId       = "to_chain_id"
 	AssetDenom      = "asset_denom"
 	ToLockProxyHash = "to_lock_proxy_hash"
+	NewOwner        = "new_owner"
 )
 
 // RegisterRoutes registers minting module REST handlers on the provided router.
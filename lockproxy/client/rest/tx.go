@@ -39,9 +39,16 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/lockproxy/create_lock_proxy", createLockProxyRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/lockproxy/create_and_delegate/{%s}/{%s}", Coin, LockProxyHash), CreateAndDelegateCoinRequestHandlerFn(cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/lockproxy/bind_proxy/{%s}/{%s}", ToChainId, ToLockProxyHash), bindProxyRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/bind_proxy/{%s}/{%s}/{%s}", LockProxyHash, ToChainId, ToLockProxyHash), bindProxyRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/lockproxy/bind_asset"), bindAssetRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/lockproxy/lock"), lockRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/unbind_proxy/{%s}/{%s}", LockProxyHash, ToChainId), unbindProxyRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/lockproxy/unbind_asset", unbindAssetRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/cancel_pending_proxy_binding/{%s}/{%s}", LockProxyHash, ToChainId), cancelPendingProxyBindingRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/lockproxy/cancel_pending_asset_binding", cancelPendingAssetBindingRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/transfer_lock_proxy_ownership/{%s}/{%s}", LockProxyHash, NewOwner), transferLockProxyOwnershipRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/accept_lock_proxy_ownership/{%s}", LockProxyHash), acceptLockProxyOwnershipRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/lockproxy/update_lock_limits", updateLockLimitsRequestHandlerFn(cliCtx)).Methods("POST")
 
 }
 
@@ -49,12 +56,38 @@ type BaseReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 }
 
+// CreateLockProxyReq names the salt the hash of the created lock proxy is derived from
+type CreateLockProxyReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Salt    string       `json:"salt" yaml:"salt"`
+}
+
 type BindAssetHashReq struct {
 	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	LockProxy   []byte       `json:"lock_proxy" yaml:"lock_proxy"`
 	Denom       string       `json:"denom" yaml:"denom"`
 	ToChainId   uint64       `json:"to_chain_id" yaml:"to_chain_id"`
 	ToAssetHash []byte       `json:"to_asset_hash" yaml:"to_asset_hash"`
 	InitialAmt  *big.Int     `json:"initial_amt" yaml:"initial_amt"`
+	// the amounts crossing the chains are scaled by Decimals, the zero value leaves them as they are
+	Decimals types.AssetDecimals `json:"decimals" yaml:"decimals"`
+}
+
+// AssetBindingReq names the asset binding to unbind or whose pending binding to cancel
+type AssetBindingReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	LockProxy []byte       `json:"lock_proxy" yaml:"lock_proxy"`
+	Denom     string       `json:"denom" yaml:"denom"`
+	ToChainId uint64       `json:"to_chain_id" yaml:"to_chain_id"`
+}
+
+// UpdateLockLimitsReq bounds the amount of Denom a single lock sends to ToChainId
+type UpdateLockLimitsReq struct {
+	BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	LockProxy []byte           `json:"lock_proxy" yaml:"lock_proxy"`
+	Denom     string           `json:"denom" yaml:"denom"`
+	ToChainId uint64           `json:"to_chain_id" yaml:"to_chain_id"`
+	Limits    types.LockLimits `json:"limits" yaml:"limits"`
 }
 
 type LockReq struct {
@@ -70,7 +103,7 @@ type LockReq struct {
 func createLockProxyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
-		var req BaseReq
+		var req CreateLockProxyReq
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
@@ -80,7 +113,7 @@ func createLockProxyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		msg := types.NewMsgCreateLockProxy(cliCtx.GetFromAddress())
+		msg := types.NewMsgCreateLockProxy(cliCtx.GetFromAddress(), req.Salt)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -119,6 +152,11 @@ func CreateAndDelegateCoinRequestHandlerFn(cliCtx context.CLIContext) http.Handl
 func bindProxyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
+		lockProxyHash, err := hex.DecodeString(vars[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		toProxyHashStr := vars[ToLockProxyHash]
 
 		targetChainId, err := strconv.ParseUint(vars[ToChainId], 10, 64)
@@ -145,7 +183,7 @@ func bindProxyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgBindProxyHash(cliCtx.GetFromAddress(), targetChainId, targetProxyHash)
+		msg := types.NewMsgBindProxyHash(cliCtx.GetFromAddress(), lockProxyHash, targetChainId, targetProxyHash)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -162,7 +200,7 @@ func bindAssetRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), req.Denom, req.ToChainId, req.ToAssetHash)
+		msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), req.LockProxy, req.Denom, req.ToChainId, req.ToAssetHash, req.Decimals)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -183,3 +221,164 @@ func lockRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func unbindProxyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		lockProxyHash, err := hex.DecodeString(vars[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		toChainId, err := strconv.ParseUint(vars[ToChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgUnbindProxyHash(cliCtx.GetFromAddress(), lockProxyHash, toChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelPendingProxyBindingRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		lockProxyHash, err := hex.DecodeString(vars[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		toChainId, err := strconv.ParseUint(vars[ToChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelPendingProxyBinding(cliCtx.GetFromAddress(), lockProxyHash, toChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func unbindAssetRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AssetBindingReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgUnbindAssetHash(cliCtx.GetFromAddress(), req.LockProxy, req.Denom, req.ToChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelPendingAssetBindingRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AssetBindingReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelPendingAssetBinding(cliCtx.GetFromAddress(), req.LockProxy, req.Denom, req.ToChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func transferLockProxyOwnershipRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		lockProxyHash, err := hex.DecodeString(vars[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		newOwner, err := sdk.AccAddressFromBech32(vars[NewOwner])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTransferLockProxyOwnership(cliCtx.GetFromAddress(), lockProxyHash, newOwner)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func acceptLockProxyOwnershipRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lockProxyHash, err := hex.DecodeString(mux.Vars(r)[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgAcceptLockProxyOwnership(cliCtx.GetFromAddress(), lockProxyHash)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func updateLockLimitsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateLockLimitsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgUpdateLockLimits(cliCtx.GetFromAddress(), req.LockProxy, req.Denom, req.ToChainId, req.Limits)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
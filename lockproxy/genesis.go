@@ -19,16 +19,74 @@ package lockproxy
 
 import (
 	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
 )
 
-// InitGenesis new mint genesis
-func InitGenesis(ctx sdk.Context, keeper Keeper) {
+// InitGenesis new lockproxy genesis, the module account restored by the auth genesis should hold the
+// recorded module balance, otherwise the escrowed coins would not back what the bindings may unlock
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	// check if the module account exists
 	moduleAcc := keeper.GetModuleAccount(ctx)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("initGenesis error: %s module account has not been set", types.ModuleName))
 	}
+	if balance := moduleAcc.GetCoins(); !types.CoinsEqual(balance, data.ModuleBalance) {
+		panic(fmt.Sprintf("initGenesis error: %s module account holds: %s, expect: %s", types.ModuleName, balance.String(), data.ModuleBalance.String()))
+	}
 
+	keeper.SetParams(ctx, data.Params)
+	for _, proxy := range data.LockProxies {
+		keeper.SetLockProxy(ctx, proxy.Operator, proxy.ProxyHash)
+		keeper.SetPendingLockProxyOwner(ctx, proxy.ProxyHash, proxy.PendingOwner)
+	}
+	for _, binding := range data.ProxyBindings {
+		keeper.SetProxyHash(ctx, binding.ProxyHash, binding.ToChainId, binding.ToProxyHash)
+	}
+	for _, binding := range data.AssetBindings {
+		keeper.SetAssetHash(ctx, binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId, binding.ToAssetHash)
+		keeper.SetAssetDecimals(ctx, binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId, binding.Decimals)
+		keeper.SetLockLimits(ctx, binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId, binding.Limits)
+	}
+	for _, binding := range data.PendingBindings.ProxyBindings {
+		keeper.SetPendingProxyBinding(ctx, binding)
+	}
+	for _, binding := range data.PendingBindings.AssetBindings {
+		keeper.SetPendingAssetBinding(ctx, binding)
+	}
+	for _, balance := range data.LockedBalances {
+		keeper.SetLockedBalance(ctx, balance.Denom, balance.ChainId, balance.Amount)
+	}
+	for _, balance := range data.ProxyBalances {
+		keeper.SetProxyBalance(ctx, balance.ProxyHash, balance.Denom, balance.Amount)
+	}
+	for _, claimant := range data.UnassignedClaimants {
+		keeper.SetUnassignedClaimant(ctx, claimant.ProxyHash, claimant.Denom)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	proxyBindings, err := keeper.GetProxyBindings(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("exportGenesis error: %s", err.Error()))
+	}
+	assetBindings, err := keeper.GetAssetBindings(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("exportGenesis error: %s", err.Error()))
+	}
+	lockedBalances, err := keeper.GetLockedBalances(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("exportGenesis error: %s", err.Error()))
+	}
+	proxyBalances, err := keeper.GetProxyBalances(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("exportGenesis error: %s", err.Error()))
+	}
+	unassignedClaimants, err := keeper.GetUnassignedClaimants(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("exportGenesis error: %s", err.Error()))
+	}
+	return NewGenesisState(keeper.GetParams(ctx), keeper.GetLockProxies(ctx), proxyBindings, assetBindings, keeper.GetAllPendingBindings(ctx), lockedBalances, proxyBalances, keeper.GetModuleAccount(ctx).GetCoins(), unassignedClaimants)
 }
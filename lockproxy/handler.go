@@ -39,6 +39,20 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBindAssetHash(ctx, k, msg)
 		case types.MsgLock:
 			return handleMsgLock(ctx, k, msg)
+		case types.MsgUnbindProxyHash:
+			return handleMsgUnbindProxyHash(ctx, k, msg)
+		case types.MsgUnbindAssetHash:
+			return handleMsgUnbindAssetHash(ctx, k, msg)
+		case types.MsgCancelPendingProxyBinding:
+			return handleMsgCancelPendingProxyBinding(ctx, k, msg)
+		case types.MsgCancelPendingAssetBinding:
+			return handleMsgCancelPendingAssetBinding(ctx, k, msg)
+		case types.MsgTransferLockProxyOwnership:
+			return handleMsgTransferLockProxyOwnership(ctx, k, msg)
+		case types.MsgAcceptLockProxyOwnership:
+			return handleMsgAcceptLockProxyOwnership(ctx, k, msg)
+		case types.MsgUpdateLockLimits:
+			return handleMsgUpdateLockLimits(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -49,7 +63,7 @@ func handleMsgCreateLockProxy(ctx sdk.Context, k keeper.Keeper, msg types.MsgCre
 
 	//err := k.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 
-	err := k.CreateLockProxy(ctx, msg.Creator)
+	err := k.CreateLockProxy(ctx, msg.Creator, msg.Salt)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +94,7 @@ func handleMsgCreateCoinAndDelegateToProxy(ctx sdk.Context, k keeper.Keeper, msg
 }
 
 func handleMsgBindProxyHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindProxyHash) (*sdk.Result, error) {
-	if err := k.BindProxyHash(ctx, msg.Operator, msg.ToChainId, msg.ToChainProxyHash); err != nil {
+	if err := k.BindProxyHash(ctx, msg.Operator, msg.LockProxyHash, msg.ToChainId, msg.ToChainProxyHash); err != nil {
 		return nil, err
 	}
 	ctx.EventManager().EmitEvent(
@@ -94,7 +108,7 @@ func handleMsgBindProxyHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindP
 
 func handleMsgBindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindAssetHash) (*sdk.Result, error) {
 
-	err := k.BindAssetHash(ctx, msg.Operator, msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash)
+	err := k.BindAssetHash(ctx, msg.Operator, msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash, msg.Decimals)
 	if err != nil {
 		return nil, err
 	}
@@ -123,3 +137,94 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgUnbindProxyHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbindProxyHash) (*sdk.Result, error) {
+	if err := k.UnbindProxyHash(ctx, msg.Operator, msg.LockProxyHash, msg.ToChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnbindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbindAssetHash) (*sdk.Result, error) {
+	if err := k.UnbindAssetHash(ctx, msg.Operator, msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelPendingProxyBinding(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelPendingProxyBinding) (*sdk.Result, error) {
+	if err := k.CancelPendingProxyBinding(ctx, msg.Operator, msg.LockProxyHash, msg.ToChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelPendingAssetBinding(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelPendingAssetBinding) (*sdk.Result, error) {
+	if err := k.CancelPendingAssetBinding(ctx, msg.Operator, msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferLockProxyOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferLockProxyOwnership) (*sdk.Result, error) {
+	if err := k.TransferLockProxyOwnership(ctx, msg.Owner, msg.LockProxyHash, msg.NewOwner); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAcceptLockProxyOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgAcceptLockProxyOwnership) (*sdk.Result, error) {
+	if err := k.AcceptLockProxyOwnership(ctx, msg.NewOwner, msg.LockProxyHash); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUpdateLockLimits(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateLockLimits) (*sdk.Result, error) {
+	if err := k.UpdateLockLimits(ctx, msg.Operator, msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId, msg.Limits); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
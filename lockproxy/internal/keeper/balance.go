@@ -0,0 +1,211 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// GetLockedBalance returns the amount of denom escrowed in the module account for chainId
+func (k Keeper) GetLockedBalance(ctx sdk.Context, denom string, chainId uint64) sdk.Int {
+	amount := sdk.ZeroInt()
+	bz := ctx.KVStore(k.storeKey).Get(GetLockedBalanceKey(denom, chainId))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	}
+	return amount
+}
+
+// SetLockedBalance records the amount of denom escrowed for chainId, a zero amount removes the record
+func (k Keeper) SetLockedBalance(ctx sdk.Context, denom string, chainId uint64, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(GetLockedBalanceKey(denom, chainId))
+		return
+	}
+	store.Set(GetLockedBalanceKey(denom, chainId), k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
+
+func (k Keeper) increaseLockedBalance(ctx sdk.Context, denom string, chainId uint64, amount sdk.Int) {
+	k.SetLockedBalance(ctx, denom, chainId, k.GetLockedBalance(ctx, denom, chainId).Add(amount))
+}
+
+// releaseLockedBalance accounts for amount of denom unlocked from chainId, it is taken from the coins locked for
+// chainId first and from the unassigned coins then, so that the unlocks of a chain never take the coins locked
+// for another chain
+func (k Keeper) releaseLockedBalance(ctx sdk.Context, denom string, chainId uint64, amount sdk.Int) error {
+	locked := k.GetLockedBalance(ctx, denom, chainId)
+	unassigned := sdk.ZeroInt()
+	if chainId != types.UnassignedChainId {
+		unassigned = k.GetLockedBalance(ctx, denom, types.UnassignedChainId)
+	}
+	if locked.Add(unassigned).LT(amount) {
+		return types.ErrLockedBalance(fmt.Sprintf("insufficient balance of denom: %s for chainId: %d, locked: %s, unassigned: %s, unlocked: %s", denom, chainId, locked.String(), unassigned.String(), amount.String()))
+	}
+	if locked.GTE(amount) {
+		k.SetLockedBalance(ctx, denom, chainId, locked.Sub(amount))
+		return nil
+	}
+	k.SetLockedBalance(ctx, denom, chainId, sdk.ZeroInt())
+	k.SetLockedBalance(ctx, denom, types.UnassignedChainId, unassigned.Sub(amount.Sub(locked)))
+	return nil
+}
+
+// GetLockedBalances returns all the locked balances, ordered by denom and chain id
+func (k Keeper) GetLockedBalances(ctx sdk.Context) ([]types.LockedBalance, error) {
+	balances := []types.LockedBalance{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), LockedBalancePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom, chainId, err := SplitLockedBalanceKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		var amount sdk.Int
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &amount); err != nil {
+			return nil, fmt.Errorf("locked balance of denom: %s for chainId: %d is malformed: %s", denom, chainId, err.Error())
+		}
+		balances = append(balances, types.NewLockedBalance(denom, chainId, amount))
+	}
+	return balances, nil
+}
+
+// GetProxyBalance returns the amount of denom escrowed in the module account by the lock proxy proxyHash
+func (k Keeper) GetProxyBalance(ctx sdk.Context, proxyHash []byte, denom string) sdk.Int {
+	amount := sdk.ZeroInt()
+	bz := ctx.KVStore(k.storeKey).Get(GetProxyBalanceKey(proxyHash, denom))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	}
+	return amount
+}
+
+// SetProxyBalance records the amount of denom escrowed by the lock proxy proxyHash, a zero amount removes the record
+func (k Keeper) SetProxyBalance(ctx sdk.Context, proxyHash []byte, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(GetProxyBalanceKey(proxyHash, denom))
+		return
+	}
+	store.Set(GetProxyBalanceKey(proxyHash, denom), k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
+
+func (k Keeper) increaseProxyBalance(ctx sdk.Context, proxyHash []byte, denom string, amount sdk.Int) {
+	k.SetProxyBalance(ctx, proxyHash, denom, k.GetProxyBalance(ctx, proxyHash, denom).Add(amount))
+}
+
+// releaseProxyBalance accounts for amount of denom unlocked by the lock proxy proxyHash, it is taken from the coins
+// escrowed by proxyHash first and from the unassigned coins then when proxyHash claims them, so that a lock proxy
+// never takes the coins escrowed by another lock proxy
+func (k Keeper) releaseProxyBalance(ctx sdk.Context, proxyHash []byte, denom string, amount sdk.Int) error {
+	escrowed := k.GetProxyBalance(ctx, proxyHash, denom)
+	unassigned := sdk.ZeroInt()
+	if k.IsUnassignedClaimant(ctx, proxyHash, denom) {
+		unassigned = k.GetProxyBalance(ctx, types.UnassignedProxyHash, denom)
+	}
+	if escrowed.Add(unassigned).LT(amount) {
+		return fmt.Errorf("insufficient balance of denom: %s escrowed by lockproxy: %x, escrowed: %s, unassigned: %s, unlocked: %s", denom, proxyHash, escrowed.String(), unassigned.String(), amount.String())
+	}
+	if escrowed.GTE(amount) {
+		k.SetProxyBalance(ctx, proxyHash, denom, escrowed.Sub(amount))
+		return nil
+	}
+	k.SetProxyBalance(ctx, proxyHash, denom, sdk.ZeroInt())
+	unassigned = unassigned.Sub(amount.Sub(escrowed))
+	k.SetProxyBalance(ctx, types.UnassignedProxyHash, denom, unassigned)
+	if unassigned.IsZero() {
+		k.deleteUnassignedClaimants(ctx, denom)
+	}
+	return nil
+}
+
+// GetProxyCoins returns the coins escrowed by the lock proxy proxyHash
+func (k Keeper) GetProxyCoins(ctx sdk.Context, proxyHash []byte) sdk.Coins {
+	coins := sdk.NewCoins()
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetProxyBalancesPrefix(proxyHash))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &amount)
+		coins = coins.Add(sdk.NewCoin(string(iter.Key()[len(GetProxyBalancesPrefix(proxyHash)):]), amount))
+	}
+	return coins
+}
+
+// GetProxyBalances returns the balances escrowed by all the lock proxies, ordered by proxy hash and denom
+func (k Keeper) GetProxyBalances(ctx sdk.Context) ([]types.ProxyBalance, error) {
+	balances := []types.ProxyBalance{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ProxyBalancePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		proxyHash, denom, err := SplitProxyBalanceKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		var amount sdk.Int
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &amount); err != nil {
+			return nil, fmt.Errorf("balance of denom: %s escrowed by lockproxy: %x is malformed: %s", denom, proxyHash, err.Error())
+		}
+		balances = append(balances, types.NewProxyBalance(proxyHash, denom, amount))
+	}
+	return balances, nil
+}
+
+// IsUnassignedClaimant tells if the lock proxy proxyHash may unlock the unassigned coins of denom
+func (k Keeper) IsUnassignedClaimant(ctx sdk.Context, proxyHash []byte, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(GetUnassignedClaimantKey(denom, proxyHash))
+}
+
+// SetUnassignedClaimant lets the lock proxy proxyHash unlock the unassigned coins of denom
+func (k Keeper) SetUnassignedClaimant(ctx sdk.Context, proxyHash []byte, denom string) {
+	ctx.KVStore(k.storeKey).Set(GetUnassignedClaimantKey(denom, proxyHash), []byte{0x01})
+}
+
+// deleteUnassignedClaimants removes the claimants of denom once its unassigned coins run out, as no lock
+// proxy may escrow coins under types.UnassignedProxyHash again
+func (k Keeper) deleteUnassignedClaimants(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetUnassignedClaimantsPrefix(denom))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetUnassignedClaimants returns the claimants of the unassigned coins, ordered by denom and proxy hash
+func (k Keeper) GetUnassignedClaimants(ctx sdk.Context) ([]types.UnassignedClaimant, error) {
+	claimants := []types.UnassignedClaimant{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), UnassignedClaimantPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom, proxyHash, err := SplitUnassignedClaimantKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		claimants = append(claimants, types.NewUnassignedClaimant(proxyHash, denom))
+	}
+	return claimants, nil
+}
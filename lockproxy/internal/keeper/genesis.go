@@ -0,0 +1,169 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// SetLockProxy records the lock proxy proxyHash owned by operator as it is, without the checks of CreateLockProxy
+func (k Keeper) SetLockProxy(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetOperatorToLockProxyKey(operator, proxyHash), proxyHash)
+	store.Set(GetLockProxyOwnerKey(proxyHash), operator.Bytes())
+}
+
+// SetPendingLockProxyOwner records the owner proxyHash is being transferred to, an empty owner removes it
+func (k Keeper) SetPendingLockProxyOwner(ctx sdk.Context, proxyHash []byte, pendingOwner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if pendingOwner.Empty() {
+		store.Delete(GetLockProxyPendingOwnerKey(proxyHash))
+		return
+	}
+	store.Set(GetLockProxyPendingOwnerKey(proxyHash), pendingOwner.Bytes())
+}
+
+// SetProxyHash records the binding of proxyHash to toProxyHash of toChainId as it is, without the checks of BindProxyHash
+func (k Keeper) SetProxyHash(ctx sdk.Context, proxyHash []byte, toChainId uint64, toProxyHash []byte) {
+	ctx.KVStore(k.storeKey).Set(GetBindProxyKey(proxyHash, toChainId), toProxyHash)
+}
+
+// SetAssetHash records the binding of sourceAssetDenom to toAssetHash of toChainId by lockProxyHash as it is,
+// without the checks of BindAssetHash
+func (k Keeper) SetAssetHash(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) {
+	ctx.KVStore(k.storeKey).Set(GetBindAssetHashKey(lockProxyHash, []byte(sourceAssetDenom), toChainId), toAssetHash)
+}
+
+// GetLockProxies returns all the created lock proxies, ordered by operator and hash
+func (k Keeper) GetLockProxies(ctx sdk.Context) []types.LockProxy {
+	return k.getLockProxies(ctx, OperatorToLockProxyKey)
+}
+
+// GetLockProxiesByOperator returns the lock proxies owned by operator, ordered by hash
+func (k Keeper) GetLockProxiesByOperator(ctx sdk.Context, operator sdk.AccAddress) []types.LockProxy {
+	return k.getLockProxies(ctx, GetOperatorToLockProxiesPrefix(operator))
+}
+
+func (k Keeper) getLockProxies(ctx sdk.Context, prefix []byte) []types.LockProxy {
+	proxies := []types.LockProxy{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		proxies = append(proxies, types.LockProxy{
+			Operator:     k.GetLockProxyOwner(ctx, iter.Value()),
+			ProxyHash:    iter.Value(),
+			PendingOwner: k.GetPendingLockProxyOwner(ctx, iter.Value()),
+		})
+	}
+	return proxies
+}
+
+// GetProxyBindings returns all the proxy bindings, ordered by proxy hash and chain id
+func (k Keeper) GetProxyBindings(ctx sdk.Context) ([]types.ProxyBinding, error) {
+	return k.getProxyBindings(ctx, BindProxyPrefix, 0, 0)
+}
+
+// GetProxyBindingsByProxy returns the limit proxy bindings of proxyHash following the first offset ones, ordered
+// by chain id
+func (k Keeper) GetProxyBindingsByProxy(ctx sdk.Context, proxyHash []byte, offset, limit int) ([]types.ProxyBinding, error) {
+	return k.getProxyBindings(ctx, GetProxyBindingsPrefix(proxyHash), offset, limit)
+}
+
+func (k Keeper) getProxyBindings(ctx sdk.Context, prefix []byte, offset, limit int) ([]types.ProxyBinding, error) {
+	bindings := []types.ProxyBinding{}
+	err := k.iteratePrefix(ctx, prefix, offset, limit, func(key, value []byte) error {
+		proxyHash, toChainId, err := SplitBindProxyKey(key)
+		if err != nil {
+			return err
+		}
+		bindings = append(bindings, types.ProxyBinding{ProxyHash: proxyHash, ToChainId: toChainId, ToProxyHash: value})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
+
+// GetAssetBindings returns all the asset bindings, ordered by lock proxy hash, denom and chain id
+func (k Keeper) GetAssetBindings(ctx sdk.Context) ([]types.AssetBinding, error) {
+	return k.getAssetBindings(ctx, BindAssetPrefix, 0, 0)
+}
+
+// GetAssetBindingsByProxy returns the limit asset bindings of sourceAssetDenom by lockProxyHash following the first
+// offset ones, ordered by chain id. All the asset bindings of lockProxyHash are listed, ordered by denom and chain
+// id, when sourceAssetDenom is empty
+func (k Keeper) GetAssetBindingsByProxy(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, offset, limit int) ([]types.AssetBinding, error) {
+	return k.getAssetBindings(ctx, GetAssetBindingsPrefix(lockProxyHash, sourceAssetDenom), offset, limit)
+}
+
+func (k Keeper) getAssetBindings(ctx sdk.Context, prefix []byte, offset, limit int) ([]types.AssetBinding, error) {
+	bindings := []types.AssetBinding{}
+	err := k.iteratePrefix(ctx, prefix, offset, limit, func(key, value []byte) error {
+		proxyHash, sourceAssetHash, toChainId, err := SplitBindAssetHashKey(key)
+		if err != nil {
+			return err
+		}
+		bindings = append(bindings, types.AssetBinding{
+			ProxyHash:        proxyHash,
+			SourceAssetDenom: string(sourceAssetHash),
+			ToChainId:        toChainId,
+			ToAssetHash:      value,
+			Decimals:         k.GetAssetDecimals(ctx, proxyHash, string(sourceAssetHash), toChainId),
+			Limits:           k.GetLockLimits(ctx, proxyHash, string(sourceAssetHash), toChainId),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
+
+// GetLockProxiesPage returns the limit lock proxies following the first offset ones, ordered by hash
+func (k Keeper) GetLockProxiesPage(ctx sdk.Context, offset, limit int) []types.LockProxy {
+	proxies := []types.LockProxy{}
+	_ = k.iteratePrefix(ctx, LockProxyOwnerPrefix, offset, limit, func(key, value []byte) error {
+		proxyHash := key[len(LockProxyOwnerPrefix):]
+		proxies = append(proxies, types.LockProxy{
+			Operator:     sdk.AccAddress(value),
+			ProxyHash:    proxyHash,
+			PendingOwner: k.GetPendingLockProxyOwner(ctx, proxyHash),
+		})
+		return nil
+	})
+	return proxies
+}
+
+// iteratePrefix calls cb on the entries under prefix, skipping the first offset of them and stopping after limit
+// of them when limit is positive
+func (k Keeper) iteratePrefix(ctx sdk.Context, prefix []byte, offset, limit int, cb func(key, value []byte) error) error {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for i := 0; iter.Valid() && (limit <= 0 || i < offset+limit); iter.Next() {
+		if i >= offset {
+			if err := cb(iter.Key(), iter.Value()); err != nil {
+				return err
+			}
+		}
+		i++
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// RegisterInvariants registers the lockproxy module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "locked-balance", LockedBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "proxy-balance", ProxyBalanceInvariant(k))
+}
+
+// LockedBalanceInvariant checks that the locked balances add up to the holdings of the module account
+func LockedBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balances, err := k.GetLockedBalances(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "locked-balance", err.Error()), true
+		}
+		sum := types.SumLockedBalances(balances)
+		holdings := k.GetModuleAccount(ctx).GetCoins()
+		broken := !types.CoinsEqual(sum, holdings)
+		return sdk.FormatInvariant(types.ModuleName, "locked-balance", fmt.Sprintf(
+			"\tsum of locked balances: %s\n\tmodule account holdings: %s\n", sum.String(), holdings.String())), broken
+	}
+}
+
+// ProxyBalanceInvariant checks that the balances escrowed by the lock proxies add up to the holdings of the module
+// account, so that each lock proxy unlocks only the coins it escrowed
+func ProxyBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balances, err := k.GetProxyBalances(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "proxy-balance", err.Error()), true
+		}
+		sum := types.SumProxyBalances(balances)
+		holdings := k.GetModuleAccount(ctx).GetCoins()
+		broken := !types.CoinsEqual(sum, holdings)
+		return sdk.FormatInvariant(types.ModuleName, "proxy-balance", fmt.Sprintf(
+			"\tsum of proxy balances: %s\n\tmodule account holdings: %s\n", sum.String(), holdings.String())), broken
+	}
+}
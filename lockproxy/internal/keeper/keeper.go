@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/polynetwork/cosmos-poly-module/common/telemetry"
 	selfexported "github.com/polynetwork/cosmos-poly-module/lockproxy/exported"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
 	polycommon "github.com/polynetwork/poly/common"
@@ -34,15 +36,20 @@ import (
 type Keeper struct {
 	cdc          *codec.Codec
 	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
 	authKeeper   types.AccountKeeper
 	supplyKeeper types.SupplyKeeper
 	ccmKeeper    types.CrossChainManager
 	selfexported.UnlockKeeper
+
+	// the addresses the unlocked coins can not be sent to
+	blacklistedAddrs map[string]bool
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance, the unlocks to the blacklistedAddrs are rejected
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, supplyKeeper types.SupplyKeeper, ccmKeeper types.CrossChainManager) Keeper {
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ak types.AccountKeeper, supplyKeeper types.SupplyKeeper, ccmKeeper types.CrossChainManager,
+	blacklistedAddrs map[string]bool) Keeper {
 
 	// ensure mint module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -52,12 +59,33 @@ func NewKeeper(
 	return Keeper{
 		cdc:          cdc,
 		storeKey:     key,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
 		authKeeper:   ak,
 		supplyKeeper: supplyKeeper,
 		ccmKeeper:    ccmKeeper,
+
+		blacklistedAddrs: blacklistedAddrs,
 	}
 }
 
+// GetParams returns the total set of lockproxy parameters, the ones not set yet by the chains the module was
+// upgraded on read as disabled
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetBindDelayBlocks(ctx))
+}
+
+// SetParams sets the total set of lockproxy parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBindDelayBlocks returns how many blocks the bindings wait before taking effect, none before it is set
+func (k Keeper) GetBindDelayBlocks(ctx sdk.Context) uint64 {
+	var blocks uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyBindDelayBlocks, &blocks)
+	return blocks
+}
+
 func (k Keeper) GetModuleAccount(ctx sdk.Context) exported.ModuleAccountI {
 	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
@@ -70,56 +98,64 @@ func (k Keeper) EnsureAccountExist(ctx sdk.Context, addr sdk.AccAddress) error {
 	return nil
 }
 
+// EnsureUnlockReceiver checks that the unlocked coins may be sent to addr, which is created by the send when it
+// does not exist yet. The blacklisted addresses and the module accounts never receive the unlocked coins
+func (k Keeper) EnsureUnlockReceiver(ctx sdk.Context, addr sdk.AccAddress) error {
+	if addr.Empty() {
+		return types.ErrUnLock("the receiver address is empty")
+	}
+	if k.blacklistedAddrs[addr.String()] {
+		return types.ErrUnLock(fmt.Sprintf("receiver: %s is blacklisted from receiving the unlocked coins", addr.String()))
+	}
+	if _, ok := k.authKeeper.GetAccount(ctx, addr).(exported.ModuleAccountI); ok {
+		return types.ErrUnLock(fmt.Sprintf("receiver: %s is a module account", addr.String()))
+	}
+	return nil
+}
+
 func (k Keeper) ContainToContractAddr(ctx sdk.Context, toContractAddr []byte, fromChainId uint64) bool {
 	return ctx.KVStore(k.storeKey).Get((GetBindProxyKey(toContractAddr, fromChainId))) != nil
 }
 
-func (k Keeper) CreateLockProxy(ctx sdk.Context, creator sdk.AccAddress) error {
-	if k.EnsureLockProxyExist(ctx, creator) {
-		return types.ErrCreateLockProxy(fmt.Sprintf("creator:%s already created lockproxy contract with hash:%x", creator.String(), creator.Bytes()))
+// CreateLockProxy creates the lock proxy operated by creator whose hash is derived from creator and salt
+func (k Keeper) CreateLockProxy(ctx sdk.Context, creator sdk.AccAddress, salt string) error {
+	proxyHash := types.GetLockProxyHash(creator, salt)
+	// the proxy hash outlives the ownership of its creator, it can not be taken again once its ownership is transferred
+	if k.EnsureLockProxyExist(ctx, proxyHash) {
+		return types.ErrCreateLockProxy(fmt.Sprintf("lockproxy contract with hash:%x already exists, owned by:%s", proxyHash, k.GetLockProxyOwner(ctx, proxyHash).String()))
 	}
-	store := ctx.KVStore(k.storeKey)
-	store.Set(GetOperatorToLockProxyKey(creator), creator.Bytes())
+	k.SetLockProxy(ctx, creator, proxyHash)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateLockProxy,
 			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
-			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(creator.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeySalt, salt),
 		),
 	})
-	ctx.Logger().With("module", fmt.Sprintf("creator:%s initialized a lockproxy contract with hash: %x", creator.String(), creator.Bytes()))
+	ctx.Logger().With("module", fmt.Sprintf("creator:%s initialized a lockproxy contract with hash: %x", creator.String(), proxyHash))
 	return nil
 }
 
-func (k Keeper) EnsureLockProxyExist(ctx sdk.Context, creator sdk.AccAddress) bool {
-	store := ctx.KVStore(k.storeKey)
-	return bytes.Equal(store.Get(GetOperatorToLockProxyKey(creator)), creator)
-}
-
-func (k Keeper) GetLockProxyByOperator(ctx sdk.Context, operator sdk.AccAddress) []byte {
-	store := ctx.KVStore(k.storeKey)
-	proxyBytes := store.Get(GetOperatorToLockProxyKey(operator))
-	if len(proxyBytes) == 0 || !bytes.Equal(operator.Bytes(), proxyBytes) {
-		return nil
-	}
-	return proxyBytes
+// EnsureLockProxyExist returns true if the lock proxy with hash proxyHash has been created
+func (k Keeper) EnsureLockProxyExist(ctx sdk.Context, proxyHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(GetLockProxyOwnerKey(proxyHash))
 }
 
 func (k Keeper) CreateCoinAndDelegateToProxy(ctx sdk.Context, creator sdk.AccAddress, coin sdk.Coin, lockproxyHash []byte) error {
-
-	if reason, exist := k.ccmKeeper.ExistDenom(ctx, coin.Denom); exist {
-		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("denom:%s already exist, reason: %s", coin.Denom, reason))
-	}
 	if exist := k.EnsureLockProxyExist(ctx, lockproxyHash); !exist {
 		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("lockproxy with hash: %s not created", lockproxyHash))
 
 	}
-	//k.SetOperator(ctx, denom, creator)
-	k.ccmKeeper.SetDenomCreator(ctx, coin.Denom, creator)
+	if err := k.ccmKeeper.RegisterDenom(ctx, creator, coin.Denom, types.ModuleName, 0, "", 0, nil); err != nil {
+		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("RegisterDenom Error: %s", err.Error()))
+	}
 
 	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("supplyKeeper.MintCoins Error: %s", err.Error()))
 	}
+	k.increaseLockedBalance(ctx, coin.Denom, types.UnassignedChainId, coin.Amount)
+	k.increaseProxyBalance(ctx, lockproxyHash, coin.Denom, coin.Amount)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateAndDelegateCoinToProxy,
@@ -131,22 +167,40 @@ func (k Keeper) CreateCoinAndDelegateToProxy(ctx sdk.Context, creator sdk.AccAdd
 	return nil
 }
 
-func (k Keeper) BindProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64, toProxyHash []byte) error {
-	if !k.EnsureLockProxyExist(ctx, operator) {
-		return types.ErrBindProxyHash(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
+func (k Keeper) BindProxyHash(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, toChainId uint64, toProxyHash []byte) error {
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrBindProxyHash(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
 	}
-	store := ctx.KVStore(k.storeKey)
+	// the binding waits for the bind delay so that a mistaken or malicious rebinding can be noticed and cancelled
+	if delay := k.GetBindDelayBlocks(ctx); delay > 0 {
+		binding := types.NewPendingProxyBinding(proxyHash, toChainId, toProxyHash, ctx.BlockHeight()+int64(delay))
+		k.SetPendingProxyBinding(ctx, binding)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePendingBindProxy,
+				sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+				sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+				sdk.NewAttribute(types.AttributeKeyToChainProxyHash, hex.EncodeToString(toProxyHash)),
+				sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatInt(binding.EffectiveHeight, 10)),
+			),
+		})
+		return nil
+	}
+	k.deletePendingProxyBinding(ctx, proxyHash, toChainId)
+	k.applyProxyBinding(ctx, proxyHash, toChainId, toProxyHash)
+	return nil
+}
 
-	store.Set(GetBindProxyKey(operator, toChainId), toProxyHash)
+func (k Keeper) applyProxyBinding(ctx sdk.Context, proxyHash []byte, toChainId uint64, toProxyHash []byte) {
+	k.SetProxyHash(ctx, proxyHash, toChainId, toProxyHash)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeBindProxy,
-			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(operator.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
 			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
 			sdk.NewAttribute(types.AttributeKeyToChainProxyHash, hex.EncodeToString(toProxyHash)),
 		),
 	})
-	return nil
 }
 
 func (k Keeper) GetProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64) []byte {
@@ -154,29 +208,57 @@ func (k Keeper) GetProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId
 	return store.Get(GetBindProxyKey(operator, toChainId))
 }
 
-func (k Keeper) BindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) error {
-	// ensure the operator has created the lockproxy contract
-	if !k.EnsureLockProxyExist(ctx, operator) {
-		return types.ErrBindAssetHash(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
+// BindAssetHash binds sourceAssetDenom to toAssetHash of toChainId, the amounts crossing the chains are scaled by decimals
+func (k Keeper) BindAssetHash(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, decimals types.AssetDecimals) error {
+	// ensure the operator operates the lockproxy contract
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrBindAssetHash(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
 	}
 	// ensure the sourceAssetDenom has already been created with non-zero supply
 	if _, exist := k.ccmKeeper.ExistDenom(ctx, sourceAssetDenom); !exist {
 		return types.ErrBindAssetHash(fmt.Sprintf("sourceAssetDenom: %s not exist", sourceAssetDenom))
 	}
-	store := ctx.KVStore(k.storeKey)
+	if err := decimals.Validate(); err != nil {
+		return types.ErrBindAssetHash(err.Error())
+	}
+	if delay := k.GetBindDelayBlocks(ctx); delay > 0 {
+		binding := types.NewPendingAssetBinding(proxyHash, sourceAssetDenom, toChainId, toAssetHash, decimals, ctx.BlockHeight()+int64(delay))
+		k.SetPendingAssetBinding(ctx, binding)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePendingBindAsset,
+				sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+				sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+				sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+				sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString(toAssetHash)),
+				sdk.NewAttribute(types.AttributeKeySourceDecimals, strconv.FormatUint(uint64(decimals.SourceDecimals), 10)),
+				sdk.NewAttribute(types.AttributeKeyToDecimals, strconv.FormatUint(uint64(decimals.ToDecimals), 10)),
+				sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatInt(binding.EffectiveHeight, 10)),
+			),
+		})
+		return nil
+	}
+	k.deletePendingAssetBinding(ctx, proxyHash, sourceAssetDenom, toChainId)
+	k.applyAssetBinding(ctx, proxyHash, sourceAssetDenom, toChainId, toAssetHash, decimals)
+	return nil
+}
+
+func (k Keeper) applyAssetBinding(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, decimals types.AssetDecimals) {
 	// store the to asset hash based on the lockproxy contract (operator) and sourceAssetHash + toChainId
-	store.Set(GetBindAssetHashKey(operator, []byte(sourceAssetDenom), toChainId), toAssetHash)
+	k.SetAssetHash(ctx, lockProxyHash, sourceAssetDenom, toChainId, toAssetHash)
+	k.SetAssetDecimals(ctx, lockProxyHash, sourceAssetDenom, toChainId, decimals)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeBindAsset,
-			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(operator.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(lockProxyHash)),
 			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
 			sdk.NewAttribute(types.AttributeKeyFromAssetHash, hex.EncodeToString([]byte(sourceAssetDenom))),
 			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
 			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString(toAssetHash)),
+			sdk.NewAttribute(types.AttributeKeySourceDecimals, strconv.FormatUint(uint64(decimals.SourceDecimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyToDecimals, strconv.FormatUint(uint64(decimals.ToDecimals), 10)),
 		),
 	})
-	return nil
 }
 
 func (k Keeper) GetAssetHash(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) []byte {
@@ -184,7 +266,97 @@ func (k Keeper) GetAssetHash(ctx sdk.Context, lockProxyHash []byte, sourceAssetD
 	return store.Get(GetBindAssetHashKey(lockProxyHash, []byte(sourceAssetDenom), toChainId))
 }
 
+// GetAssetDecimals returns the decimals the amounts of sourceAssetDenom crossing to and from toChainId are scaled by,
+// the zero value leaving them as they are when none were recorded
+func (k Keeper) GetAssetDecimals(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) types.AssetDecimals {
+	var decimals types.AssetDecimals
+	bz := ctx.KVStore(k.storeKey).Get(GetAssetDecimalsKey(lockProxyHash, sourceAssetDenom, toChainId))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &decimals)
+	}
+	return decimals
+}
+
+// SetAssetDecimals records the decimals of the asset binding of sourceAssetDenom to toChainId, the zero value removes them
+func (k Keeper) SetAssetDecimals(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64, decimals types.AssetDecimals) {
+	store := ctx.KVStore(k.storeKey)
+	if decimals == (types.AssetDecimals{}) {
+		store.Delete(GetAssetDecimalsKey(lockProxyHash, sourceAssetDenom, toChainId))
+		return
+	}
+	store.Set(GetAssetDecimalsKey(lockProxyHash, sourceAssetDenom, toChainId), k.cdc.MustMarshalBinaryLengthPrefixed(decimals))
+}
+
+// GetLockLimits returns the bounds of the amount of sourceAssetDenom a single lock sends to toChainId, which are
+// empty when the operator set none
+func (k Keeper) GetLockLimits(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) types.LockLimits {
+	limits := types.LockLimits{}
+	bz := ctx.KVStore(k.storeKey).Get(GetLockLimitsKey(lockProxyHash, sourceAssetDenom, toChainId))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &limits)
+	}
+	return limits.Normalize()
+}
+
+// SetLockLimits records the lock limits of the asset binding of sourceAssetDenom to toChainId, the empty limits remove them
+func (k Keeper) SetLockLimits(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64, limits types.LockLimits) {
+	store := ctx.KVStore(k.storeKey)
+	if limits.IsEmpty() {
+		store.Delete(GetLockLimitsKey(lockProxyHash, sourceAssetDenom, toChainId))
+		return
+	}
+	store.Set(GetLockLimitsKey(lockProxyHash, sourceAssetDenom, toChainId), k.cdc.MustMarshalBinaryLengthPrefixed(limits.Normalize()))
+}
+
+// UpdateLockLimits bounds the amount of sourceAssetDenom a single lock sends to toChainId through the lock proxy
+// proxyHash of operator, at once as the limits only restrict the locks, the asset binding must be in effect
+func (k Keeper) UpdateLockLimits(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, sourceAssetDenom string, toChainId uint64, limits types.LockLimits) error {
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrLockLimits(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
+	}
+	if err := limits.Validate(); err != nil {
+		return types.ErrLockLimits(err.Error())
+	}
+	if k.GetAssetHash(ctx, proxyHash, sourceAssetDenom, toChainId) == nil {
+		return types.ErrLockLimits(fmt.Sprintf("denom: %s of lockproxy: %x is not bound to chainId: %d", sourceAssetDenom, proxyHash, toChainId))
+	}
+	limits = limits.Normalize()
+	k.SetLockLimits(ctx, proxyHash, sourceAssetDenom, toChainId, limits)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateLockLimits,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinAmount, limits.MinAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxAmount, limits.MaxAmount.String()),
+		),
+	})
+	return nil
+}
+
+// Lock escrows value of sourceAssetDenom and sends it to toChainId scaled by the decimals of the asset binding, the
+// dust too small to be represented on toChainId is not escrowed and stays with fromAddress. The escrowed amount must
+// be within the lock limits of the asset binding
 func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddressBs []byte, value sdk.Int) error {
+	toValue, dust, err := k.GetAssetDecimals(ctx, lockProxyHash, sourceAssetDenom, toChainId).ToChainAmount(value)
+	if err != nil {
+		return types.ErrLock(err.Error())
+	}
+	if !toValue.IsPositive() {
+		return types.ErrLock(fmt.Sprintf("amount: %s of denom: %s is below the smallest unit of the asset of chainId: %d", value.String(), sourceAssetDenom, toChainId))
+	}
+	value = value.Sub(dust)
+	if err := k.GetLockLimits(ctx, lockProxyHash, sourceAssetDenom, toChainId).Check(value); err != nil {
+		return types.ErrLock(fmt.Sprintf("denom: %s to chainId: %d, %s", sourceAssetDenom, toChainId, err.Error()))
+	}
+	// ensure the destination chain is registered and can receive the address and amount
+	if err := k.ccmKeeper.ValidateToAddress(ctx, toChainId, toAddressBs); err != nil {
+		return types.ErrLock(fmt.Sprintf("ccmKeeper.ValidateToAddress Error: %s", err.Error()))
+	}
+	if err := k.ccmKeeper.ValidateToAmount(ctx, toChainId, toValue); err != nil {
+		return types.ErrLock(fmt.Sprintf("ccmKeeper.ValidateToAmount Error: %s", err.Error()))
+	}
 	// send coin of sourceAssetDenom from fromAddress to module account address
 	amt := sdk.NewCoins(sdk.NewCoin(sourceAssetDenom, value))
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, types.ModuleName, amt); err != nil {
@@ -200,7 +372,7 @@ func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccA
 	args := types.TxArgs{
 		ToAssetHash: toChainAssetHash,
 		ToAddress:   toAddressBs,
-		Amount:      value.BigInt(),
+		Amount:      toValue.BigInt(),
 	}
 	if err := args.Serialization(sink, 32); err != nil {
 		return types.ErrLock(fmt.Sprintf("TxArgs Serialization Error:%v", err))
@@ -217,6 +389,8 @@ func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccA
 	if amt.AmountOf(sourceAssetDenom).IsNegative() {
 		return types.ErrLock(fmt.Sprintf("the coin being crossed has negative amount value, coin:%s", amt.String()))
 	}
+	k.increaseLockedBalance(ctx, sourceAssetDenom, toChainId, value)
+	k.increaseProxyBalance(ctx, lockProxyHash, sourceAssetDenom, value)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeLock,
@@ -226,13 +400,18 @@ func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccA
 			sdk.NewAttribute(types.AttributeKeyFromAddress, fromAddress.String()),
 			sdk.NewAttribute(types.AttributeKeyToAddress, hex.EncodeToString(toAddressBs)),
 			sdk.NewAttribute(types.AttributeKeyAmount, value.String()),
+			sdk.NewAttribute(types.AttributeKeyToAmount, toValue.String()),
+			sdk.NewAttribute(types.AttributeKeyDust, dust.String()),
 			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(fromContractHash)),
 		),
 	})
 
+	telemetry.RecordLock(ctx, types.ModuleName, sourceAssetDenom, value)
 	return nil
 }
 
+// Unlock sends the coins of the asset binding back to the receiver of argsBs, the amount from fromChainId is scaled
+// back by the decimals of the asset binding and its dust too small to be represented by the denom is left out
 func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk.AccAddress, toContractAddr []byte, argsBs []byte) error {
 
 	fromProxyHash := k.GetProxyHash(ctx, toContractAddr, fromChainId)
@@ -256,15 +435,29 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 		return types.ErrUnLock(fmt.Sprintf("toAssetHash: %x of denom: %s doesnot belong to the current lock proxy hash: %x", toAssetHash, toAssetDenom, toContractAddr))
 	}
 
-	// mint coin of sourceAssetDenom
-	amt := sdk.NewCoins(sdk.NewCoin(toAssetDenom, sdk.NewIntFromBigInt(amount)))
+	// scale the amount of the asset of fromChainId back by the decimals of the asset binding
+	value, dust, err := k.GetAssetDecimals(ctx, toContractAddr, toAssetDenom, fromChainId).FromChainAmount(sdk.NewIntFromBigInt(amount))
+	if err != nil {
+		return types.ErrUnLock(err.Error())
+	}
+	amt := sdk.NewCoins(sdk.NewCoin(toAssetDenom, value))
 
 	toAcctAddress := make(sdk.AccAddress, len(toAddress))
 	copy(toAcctAddress, toAddress)
 
-	if err := k.EnsureAccountExist(ctx, toAddress); err != nil {
+	if err := k.EnsureUnlockReceiver(ctx, toAcctAddress); err != nil {
 		return err
 	}
+	// the lock proxy only unlocks the coins it escrowed, never the ones escrowed by the other lock proxies, both
+	// balances are released or none is
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.releaseProxyBalance(cacheCtx, toContractAddr, toAssetDenom, value); err != nil {
+		return types.ErrUnLock(err.Error())
+	}
+	if err := k.releaseLockedBalance(cacheCtx, toAssetDenom, fromChainId, value); err != nil {
+		return types.ErrUnLock(err.Error())
+	}
+	writeCache()
 	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAcctAddress, amt); err != nil {
 		return types.ErrUnLock(fmt.Sprintf("supplyKeeper.SendCoinsFromModuleToAccount, Error: send coins:%s from Module account:%s to receiver account:%s error", amt.String(), k.GetModuleAccount(ctx).GetAddress().String(), toAcctAddress.String()))
 	}
@@ -274,8 +467,11 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 			types.EventTypeUnlock,
 			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString([]byte(toAssetDenom))),
 			sdk.NewAttribute(types.AttributeKeyToAddress, toAcctAddress.String()),
-			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, value.String()),
+			sdk.NewAttribute(types.AttributeKeyFromAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDust, dust.String()),
 		),
 	})
+	telemetry.RecordUnlock(ctx, types.ModuleName, toAssetDenom, value)
 	return nil
 }
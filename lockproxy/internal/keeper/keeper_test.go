@@ -18,13 +18,20 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy"
 	"github.com/polynetwork/cosmos-poly-module/simapp"
+	polycommon "github.com/polynetwork/poly/common"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"gotest.tools/assert"
+	"math/big"
 	"testing"
 )
 
@@ -39,7 +46,7 @@ func Test_lockproxy_CreateLockProxy(t *testing.T) {
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
 
 	validLockProxy := sdk.AccAddress([]byte("validLockProxy"))
-	err := app.LockProxyKeeper.CreateLockProxy(ctx, validLockProxy)
+	err := app.LockProxyKeeper.CreateLockProxy(ctx, validLockProxy, "")
 	require.Nil(t, err)
 
 	testCases := []struct {
@@ -60,11 +67,11 @@ func Test_lockproxy_CreateLockProxy(t *testing.T) {
 		coin, err := sdk.ParseCoin(testCase.coin)
 		require.Nil(t, err)
 		if testCase.createProxy == 1 {
-			err = app.LockProxyKeeper.CreateLockProxy(ctx, creator)
+			err = app.LockProxyKeeper.CreateLockProxy(ctx, creator, "")
 			require.Nil(t, err)
 		}
 
-		err = app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, creator, coin, creator)
+		err = app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, creator, coin, lockproxy.GetLockProxyHash(creator, ""))
 		if testCase.expectSucceed {
 			require.Nil(t, err)
 		} else {
@@ -78,8 +85,9 @@ func Test_lockproxy_CreateCoinAndDelegateToProxy(t *testing.T) {
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
 
 	validLockProxy := sdk.AccAddress([]byte("validLockProxy"))
-	err := app.LockProxyKeeper.CreateLockProxy(ctx, validLockProxy)
+	err := app.LockProxyKeeper.CreateLockProxy(ctx, validLockProxy, "")
 	require.Nil(t, err)
+	validLockProxyHash := lockproxy.GetLockProxyHash(validLockProxy, "")
 
 	testCases := []struct {
 		address       string
@@ -87,11 +95,11 @@ func Test_lockproxy_CreateCoinAndDelegateToProxy(t *testing.T) {
 		lockProxy     []byte
 		expectSucceed bool
 	}{
-		{"addr1", "100coin1", validLockProxy, true},
-		{"addr1", "10000000000000000000000000000000000000000000000000000000000000000000000000coin2", validLockProxy, true},
-		{"addr1", "3coin3", validLockProxy, true},
-		{"addr1", "4coin4", validLockProxy, true},
-		{"addr2", "100coin6", sdk.AccAddress([]byte("invalidLockProxy")), false},
+		{"addr1", "100coin1", validLockProxyHash, true},
+		{"addr1", "10000000000000000000000000000000000000000000000000000000000000000000000000coin2", validLockProxyHash, true},
+		{"addr1", "3coin3", validLockProxyHash, true},
+		{"addr1", "4coin4", validLockProxyHash, true},
+		{"addr2", "100coin6", validLockProxy, false},
 	}
 	for _, testCase := range testCases {
 		addr := sdk.AccAddress([]byte(testCase.address))
@@ -135,9 +143,10 @@ func Test_lockproxy_BindProxyHash(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		proxyCreator := sdk.AccAddress([]byte(testCase.lockProxyCreator))
+		proxyHash := lockproxy.GetLockProxyHash(proxyCreator, "")
 
 		if testCase.createProxy == 1 {
-			err := app.LockProxyKeeper.CreateLockProxy(ctx, proxyCreator)
+			err := app.LockProxyKeeper.CreateLockProxy(ctx, proxyCreator, "")
 			if testCase.createProxySucceed {
 				require.Nil(t, err, "expect create lock proxy nil")
 			} else {
@@ -149,7 +158,7 @@ func Test_lockproxy_BindProxyHash(t *testing.T) {
 		require.Nil(t, err, "expect parse coin nil")
 		if testCase.createCoin == 1 {
 
-			err := app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, coinCreator, coin, proxyCreator)
+			err := app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, coinCreator, coin, proxyHash)
 			if testCase.createCoinSucceed {
 				require.Nil(t, err, "expect create lock proxy nil")
 			} else {
@@ -157,7 +166,7 @@ func Test_lockproxy_BindProxyHash(t *testing.T) {
 			}
 		}
 
-		err = app.LockProxyKeeper.BindAssetHash(ctx, proxyCreator, coin.Denom, testCase.toChainId, testCase.toAssetHash)
+		err = app.LockProxyKeeper.BindAssetHash(ctx, proxyCreator, proxyHash, coin.Denom, testCase.toChainId, testCase.toAssetHash, lockproxy.AssetDecimals{})
 		if testCase.bindSucceed {
 			require.Nil(t, err)
 		} else {
@@ -166,3 +175,579 @@ func Test_lockproxy_BindProxyHash(t *testing.T) {
 
 	}
 }
+
+// fixture keys of the consensus version 1 layout
+func v1BindProxyKey(proxyHash []byte, toChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, toChainId)
+	return append(append([]byte{0x02}, proxyHash...), b...)
+}
+
+func v1BindAssetHashKey(lockProxyHash []byte, sourceAssetHash []byte, toChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, toChainId)
+	return append(append(append([]byte{0x03}, lockProxyHash...), sourceAssetHash...), b...)
+}
+
+func Test_lockproxy_MigrateBindKeys(t *testing.T) {
+	app, ctx := createTestApp(true)
+	store := ctx.KVStore(app.GetKey(lockproxy.StoreKey))
+
+	proxyA := sdk.AccAddress([]byte("proxyA_operator_addr"))
+	proxyB := sdk.AccAddress([]byte("proxyB_operator_addr"))
+	// the lock proxies of version 1 are hashed as their operator
+	app.LockProxyKeeper.SetLockProxy(ctx, proxyA, proxyA)
+	app.LockProxyKeeper.SetLockProxy(ctx, proxyB, proxyB)
+
+	store.Set(v1BindProxyKey(proxyA, 2), []byte{0xa2})
+	store.Set(v1BindProxyKey(proxyA, 0x0300), []byte{0xa3})
+	store.Set(v1BindProxyKey(proxyB, 2), []byte{0xb2})
+	store.Set(v1BindAssetHashKey(proxyA, []byte("coin1"), 2), []byte{0x12})
+	store.Set(v1BindAssetHashKey(proxyA, []byte("coin1"), 0x0300), []byte{0x13})
+	store.Set(v1BindAssetHashKey(proxyB, []byte("coin2"), 2), []byte{0x22})
+
+	require.Nil(t, app.LockProxyKeeper.MigrateBindKeys(ctx))
+
+	require.Equal(t, []byte{0xa2}, app.LockProxyKeeper.GetProxyHash(ctx, proxyA, 2))
+	require.Equal(t, []byte{0xa3}, app.LockProxyKeeper.GetProxyHash(ctx, proxyA, 0x0300))
+	require.Equal(t, []byte{0xb2}, app.LockProxyKeeper.GetProxyHash(ctx, proxyB, 2))
+	require.Nil(t, app.LockProxyKeeper.GetProxyHash(ctx, proxyB, 0x0300))
+	require.Equal(t, []byte{0x12}, app.LockProxyKeeper.GetAssetHash(ctx, proxyA, "coin1", 2))
+	require.Equal(t, []byte{0x13}, app.LockProxyKeeper.GetAssetHash(ctx, proxyA, "coin1", 0x0300))
+	require.Equal(t, []byte{0x22}, app.LockProxyKeeper.GetAssetHash(ctx, proxyB, "coin2", 2))
+	require.True(t, app.LockProxyKeeper.ContainToContractAddr(ctx, proxyA, 0x0300))
+
+	require.False(t, store.Has(v1BindProxyKey(proxyA, 2)))
+	require.False(t, store.Has(v1BindAssetHashKey(proxyB, []byte("coin2"), 2)))
+	count := 0
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	require.Equal(t, 2*2+6, count)
+
+	// bindings whose lock proxy is unknown cannot be split
+	store.Set(v1BindAssetHashKey([]byte("unknown"), []byte("coin3"), 2), []byte{0x32})
+	require.Error(t, app.LockProxyKeeper.MigrateBindKeys(ctx))
+}
+
+func Test_lockproxy_LockedBalance(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	operator := sdk.AccAddress([]byte("lockproxy_operator"))
+	receiver := sdk.AccAddress([]byte("lockproxy_receiver"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, receiver))
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, operator, ""))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(100)), proxy))
+	require.Equal(t, sdk.NewInt(100), app.LockProxyKeeper.GetLockedBalance(ctx, "lpcoin", lockproxy.UnassignedChainId))
+	for _, chainId := range []uint64{2, 3} {
+		require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, operator, proxy, chainId, []byte{byte(chainId)}))
+		require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, operator, proxy, "lpcoin", chainId, []byte("lpcoin"), lockproxy.AssetDecimals{}))
+	}
+	// coins locked for chain 2, as recorded by Lock
+	app.LockProxyKeeper.SetLockedBalance(ctx, "lpcoin", 2, sdk.NewInt(50))
+	app.LockProxyKeeper.SetProxyBalance(ctx, proxy, "lpcoin", sdk.NewInt(150))
+	require.Nil(t, app.SupplyKeeper.MintCoins(ctx, lockproxy.ModuleName, sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(50)))))
+	_, broken := lockproxy.LockedBalanceInvariant(app.LockProxyKeeper)(ctx)
+	require.False(t, broken)
+
+	unlockArgs := func(amount int64) []byte {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := lockproxy.TxArgs{ToAssetHash: []byte("lpcoin"), ToAddress: receiver, Amount: big.NewInt(amount)}
+		require.Nil(t, args.Serialization(sink, 32))
+		return sink.Bytes()
+	}
+	// chain 3 can take the unassigned coins but not the ones locked for chain 2
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, 3, []byte{3}, proxy, unlockArgs(80)))
+	require.Equal(t, sdk.NewInt(20), app.LockProxyKeeper.GetLockedBalance(ctx, "lpcoin", lockproxy.UnassignedChainId))
+	require.Error(t, app.LockProxyKeeper.Unlock(ctx, 3, []byte{3}, proxy, unlockArgs(30)))
+	// chain 2 takes its own coins first
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, 2, []byte{2}, proxy, unlockArgs(60)))
+	require.Equal(t, sdk.ZeroInt(), app.LockProxyKeeper.GetLockedBalance(ctx, "lpcoin", 2))
+	require.Equal(t, sdk.NewInt(10), app.LockProxyKeeper.GetLockedBalance(ctx, "lpcoin", lockproxy.UnassignedChainId))
+	require.Equal(t, sdk.NewInt(140), app.AccountKeeper.GetAccount(ctx, receiver).GetCoins().AmountOf("lpcoin"))
+	_, broken = lockproxy.LockedBalanceInvariant(app.LockProxyKeeper)(ctx)
+	require.False(t, broken)
+
+	moduleAddr := app.LockProxyKeeper.GetModuleAccount(ctx).GetAddress()
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, moduleAddr, sdk.NewCoins(sdk.NewCoin("othercoin", sdk.NewInt(10)))))
+	_, broken = lockproxy.LockedBalanceInvariant(app.LockProxyKeeper)(ctx)
+	require.True(t, broken)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, moduleAddr, sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(11)))))
+	_, broken = lockproxy.LockedBalanceInvariant(app.LockProxyKeeper)(ctx)
+	require.True(t, broken)
+
+	// the migration assigns the holdings of the module account to no chain
+	require.Nil(t, app.LockProxyKeeper.MigrateLockedBalances(ctx))
+	balances, err := app.LockProxyKeeper.GetLockedBalances(ctx)
+	require.Nil(t, err)
+	require.Equal(t, []lockproxy.LockedBalance{lockproxy.NewLockedBalance("lpcoin", lockproxy.UnassignedChainId, sdk.NewInt(11))}, balances)
+}
+
+func Test_lockproxy_PendingBindings(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy_operator"))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, ""))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(100)), proxy))
+	k.SetParams(ctx, lockproxy.NewParams(10))
+	ctx = ctx.WithBlockHeight(1)
+
+	// bindings wait for the bind delay
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x02}))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.AssetDecimals{}))
+	require.Nil(t, k.GetProxyHash(ctx, proxy, 2))
+	require.Nil(t, k.GetAssetHash(ctx, proxy, "lpcoin", 2))
+	require.Equal(t, lockproxy.PendingBindings{
+		ProxyBindings: []lockproxy.PendingProxyBinding{lockproxy.NewPendingProxyBinding(proxy, 2, []byte{0x02}, 11)},
+		AssetBindings: []lockproxy.PendingAssetBinding{lockproxy.NewPendingAssetBinding(proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.AssetDecimals{}, 11)},
+	}, k.GetPendingBindings(ctx, proxy))
+	lockproxy.EndBlocker(ctx.WithBlockHeight(10), k)
+	require.Nil(t, k.GetProxyHash(ctx, proxy, 2))
+	lockproxy.EndBlocker(ctx.WithBlockHeight(11), k)
+	require.Equal(t, []byte{0x02}, k.GetProxyHash(ctx, proxy, 2))
+	require.Equal(t, []byte("lpcoin"), k.GetAssetHash(ctx, proxy, "lpcoin", 2))
+	require.Empty(t, k.GetPendingBindings(ctx, proxy).ProxyBindings)
+	require.Empty(t, k.GetPendingBindings(ctx, proxy).AssetBindings)
+
+	// a cancelled rebinding never takes effect
+	ctx = ctx.WithBlockHeight(12)
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x03}))
+	require.Equal(t, []byte{0x02}, k.GetProxyHash(ctx, proxy, 2))
+	require.Nil(t, k.CancelPendingProxyBinding(ctx, operator, proxy, 2))
+	require.Error(t, k.CancelPendingProxyBinding(ctx, operator, proxy, 2))
+	lockproxy.EndBlocker(ctx.WithBlockHeight(22), k)
+	require.Equal(t, []byte{0x02}, k.GetProxyHash(ctx, proxy, 2))
+
+	// a rebinding replaces the pending one of the same binding
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x03}))
+	require.Nil(t, k.BindProxyHash(ctx.WithBlockHeight(15), operator, proxy, 2, []byte{0x04}))
+	lockproxy.EndBlocker(ctx.WithBlockHeight(22), k)
+	require.Equal(t, []byte{0x02}, k.GetProxyHash(ctx, proxy, 2))
+	lockproxy.EndBlocker(ctx.WithBlockHeight(25), k)
+	require.Equal(t, []byte{0x04}, k.GetProxyHash(ctx, proxy, 2))
+
+	// unbinding takes effect at once and drops the pending rebinding
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("other"), lockproxy.AssetDecimals{}))
+	require.Nil(t, k.UnbindAssetHash(ctx, operator, proxy, "lpcoin", 2))
+	require.Nil(t, k.GetAssetHash(ctx, proxy, "lpcoin", 2))
+	_, found := k.GetPendingAssetBinding(ctx, proxy, "lpcoin", 2)
+	require.False(t, found)
+	require.Error(t, k.UnbindAssetHash(ctx, operator, proxy, "lpcoin", 2))
+	require.Nil(t, k.UnbindProxyHash(ctx, operator, proxy, 2))
+	require.Nil(t, k.GetProxyHash(ctx, proxy, 2))
+	require.Error(t, k.UnbindProxyHash(ctx, operator, proxy, 2))
+	require.Error(t, k.UnbindProxyHash(ctx, sdk.AccAddress([]byte("not_an_operator")), proxy, 2))
+
+	// without delay the bindings take effect at once, replacing the pending ones
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 3, []byte{0x03}))
+	k.SetParams(ctx, lockproxy.NewParams(0))
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 3, []byte{0x33}))
+	require.Equal(t, []byte{0x33}, k.GetProxyHash(ctx, proxy, 3))
+	_, found = k.GetPendingProxyBinding(ctx, proxy, 3)
+	require.False(t, found)
+	lockproxy.EndBlocker(ctx.WithBlockHeight(100), k)
+	require.Equal(t, []byte{0x33}, k.GetProxyHash(ctx, proxy, 3))
+}
+
+func Test_lockproxy_TransferOwnership(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	k := app.LockProxyKeeper
+
+	creator := sdk.AccAddress([]byte("lockproxy_creator"))
+	newOwner := sdk.AccAddress([]byte("lockproxy_new_owner"))
+	other := sdk.AccAddress([]byte("lockproxy_other"))
+	require.Nil(t, k.CreateLockProxy(ctx, creator, ""))
+	proxy := lockproxy.GetLockProxyHash(creator, "")
+	require.Nil(t, k.BindProxyHash(ctx, creator, proxy, 2, []byte{0x02}))
+
+	// proposing the owner itself cancels the transfer
+	require.Error(t, k.TransferLockProxyOwnership(ctx, newOwner, proxy, other))
+	require.Nil(t, k.TransferLockProxyOwnership(ctx, creator, proxy, other))
+	require.Equal(t, other, k.GetPendingLockProxyOwner(ctx, proxy))
+	require.Nil(t, k.TransferLockProxyOwnership(ctx, creator, proxy, creator))
+	require.Empty(t, k.GetPendingLockProxyOwner(ctx, proxy))
+	require.Error(t, k.AcceptLockProxyOwnership(ctx, other, proxy))
+
+	// only the proposed owner can accept, whatever the proxies it already operates
+	require.Nil(t, k.CreateLockProxy(ctx, newOwner, ""))
+	require.Nil(t, k.TransferLockProxyOwnership(ctx, creator, proxy, newOwner))
+	require.Error(t, k.AcceptLockProxyOwnership(ctx, other, proxy))
+	require.Nil(t, k.AcceptLockProxyOwnership(ctx, newOwner, proxy))
+	require.Equal(t, newOwner, k.GetLockProxyOwner(ctx, proxy))
+	require.Empty(t, k.GetPendingLockProxyOwner(ctx, proxy))
+	require.Len(t, k.GetLockProxiesByOperator(ctx, newOwner), 2)
+	require.Empty(t, k.GetLockProxiesByOperator(ctx, creator))
+	require.Error(t, k.AcceptLockProxyOwnership(ctx, newOwner, proxy))
+
+	// the proxy keeps its hash and bindings, operated by the new owner only
+	require.Equal(t, []byte{0x02}, k.GetProxyHash(ctx, proxy, 2))
+	require.Nil(t, k.BindProxyHash(ctx, newOwner, proxy, 3, []byte{0x03}))
+	require.Equal(t, []byte{0x03}, k.GetProxyHash(ctx, proxy, 3))
+	require.Error(t, k.BindProxyHash(ctx, creator, proxy, 4, []byte{0x04}))
+	require.Error(t, k.UnbindProxyHash(ctx, creator, proxy, 2))
+	require.Error(t, k.CreateLockProxy(ctx, creator, ""))
+
+	// owners are migrated from the operator index of version 3
+	store := ctx.KVStore(app.GetKey(lockproxy.StoreKey))
+	legacy := sdk.AccAddress([]byte("lockproxy_legacy"))
+	store.Set(append(lockproxy.OperatorToLockProxyKey, legacy...), legacy.Bytes())
+	require.Nil(t, k.MigrateLockProxyOwners(ctx))
+	require.Equal(t, legacy, k.GetLockProxyOwner(ctx, legacy))
+}
+
+func Test_lockproxy_MultipleLockProxies(t *testing.T) {
+	app, ctx := createTestApp(true)
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy_operator"))
+	other := sdk.AccAddress([]byte("lockproxy_other"))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, "usdt"))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, "usdc"))
+	require.Error(t, k.CreateLockProxy(ctx, operator, "usdt"))
+	require.Nil(t, k.CreateLockProxy(ctx, other, "usdt"))
+
+	usdt, usdc := lockproxy.GetLockProxyHash(operator, "usdt"), lockproxy.GetLockProxyHash(operator, "usdc")
+	require.NotEqual(t, usdt, usdc)
+	require.NotEqual(t, usdt, lockproxy.GetLockProxyHash(other, "usdt"))
+	require.True(t, k.EnsureLockProxyExist(ctx, usdt))
+	require.False(t, k.EnsureLockProxyExist(ctx, operator))
+	proxies := k.GetLockProxiesByOperator(ctx, operator)
+	require.Len(t, proxies, 2)
+	for _, proxy := range proxies {
+		require.Equal(t, operator, proxy.Operator)
+		require.True(t, bytes.Equal(proxy.ProxyHash, usdt) || bytes.Equal(proxy.ProxyHash, usdc))
+	}
+
+	// the bindings of each proxy are apart, and only its operator binds them
+	require.Nil(t, k.BindProxyHash(ctx, operator, usdt, 2, []byte{0x02}))
+	require.Nil(t, k.BindProxyHash(ctx, operator, usdc, 2, []byte{0x12}))
+	require.Error(t, k.BindProxyHash(ctx, other, usdt, 2, []byte{0x22}))
+	require.Equal(t, []byte{0x02}, k.GetProxyHash(ctx, usdt, 2))
+	require.Equal(t, []byte{0x12}, k.GetProxyHash(ctx, usdc, 2))
+	require.Nil(t, k.UnbindProxyHash(ctx, operator, usdt, 2))
+	require.Nil(t, k.GetProxyHash(ctx, usdt, 2))
+	require.Equal(t, []byte{0x12}, k.GetProxyHash(ctx, usdc, 2))
+
+	// the index of version 4 kept the single lock proxy of an operator under the operator
+	store := ctx.KVStore(app.GetKey(lockproxy.StoreKey))
+	legacy := sdk.AccAddress([]byte("lockproxy_legacy"))
+	store.Set(lockproxy.GetLockProxyOwnerKey(legacy), legacy.Bytes())
+	store.Delete(lockproxy.GetOperatorToLockProxyKey(operator, usdt))
+	store.Delete(lockproxy.GetOperatorToLockProxyKey(operator, usdc))
+	store.Delete(lockproxy.GetOperatorToLockProxyKey(other, lockproxy.GetLockProxyHash(other, "usdt")))
+	store.Set(append(lockproxy.OperatorToLockProxyKey, legacy...), legacy.Bytes())
+	require.Nil(t, k.MigrateOperatorIndex(ctx))
+	require.Equal(t, []lockproxy.LockProxy{{Operator: legacy, ProxyHash: legacy.Bytes()}}, k.GetLockProxies(ctx))
+	require.True(t, k.IsLockProxyOwner(ctx, legacy, legacy))
+}
+
+func Test_lockproxy_ProxyBalance(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy_operator"))
+	other := sdk.AccAddress([]byte("lockproxy_other"))
+	receiver := sdk.AccAddress([]byte("lockproxy_receiver"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, receiver))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, ""))
+	require.Nil(t, k.CreateLockProxy(ctx, other, ""))
+	proxy, otherProxy := lockproxy.GetLockProxyHash(operator, ""), lockproxy.GetLockProxyHash(other, "")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(100)), proxy))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(100))), k.GetProxyCoins(ctx, proxy))
+	require.True(t, k.GetProxyCoins(ctx, otherProxy).IsZero())
+
+	// both proxies bind lpcoin, but the other proxy escrowed none of it
+	for _, p := range []struct {
+		operator sdk.AccAddress
+		hash     []byte
+	}{{operator, proxy}, {other, otherProxy}} {
+		require.Nil(t, k.BindProxyHash(ctx, p.operator, p.hash, 2, []byte{0x02}))
+		require.Nil(t, k.BindAssetHash(ctx, p.operator, p.hash, "lpcoin", 2, []byte("lpcoin"), lockproxy.AssetDecimals{}))
+	}
+	sink := polycommon.NewZeroCopySink(nil)
+	args := lockproxy.TxArgs{ToAssetHash: []byte("lpcoin"), ToAddress: receiver, Amount: big.NewInt(60)}
+	require.Nil(t, args.Serialization(sink, 32))
+	require.Error(t, k.Unlock(ctx, 2, []byte{0x02}, otherProxy, sink.Bytes()))
+	require.Nil(t, k.Unlock(ctx, 2, []byte{0x02}, proxy, sink.Bytes()))
+	require.Error(t, k.Unlock(ctx, 2, []byte{0x02}, proxy, sink.Bytes()))
+	require.Equal(t, sdk.NewInt(40), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+	_, broken := lockproxy.ProxyBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the holdings of a denom bound by both proxies are assigned to neither of them, both can unlock them
+	k.SetProxyBalance(ctx, proxy, "lpcoin", sdk.ZeroInt())
+	_, broken = lockproxy.ProxyBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Nil(t, k.MigrateProxyBalances(ctx))
+	balances, err := k.GetProxyBalances(ctx)
+	require.Nil(t, err)
+	require.Equal(t, []lockproxy.ProxyBalance{lockproxy.NewProxyBalance(lockproxy.UnassignedProxyHash, "lpcoin", sdk.NewInt(40))}, balances)
+	_, broken = lockproxy.ProxyBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+	claimants, err := k.GetUnassignedClaimants(ctx)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []lockproxy.UnassignedClaimant{lockproxy.NewUnassignedClaimant(proxy, "lpcoin"), lockproxy.NewUnassignedClaimant(otherProxy, "lpcoin")}, claimants)
+
+	// a proxy binding lpcoin after the migration can not unlock the unassigned coins
+	stranger := sdk.AccAddress([]byte("lockproxy_stranger"))
+	require.Nil(t, k.CreateLockProxy(ctx, stranger, ""))
+	strangerProxy := lockproxy.GetLockProxyHash(stranger, "")
+	require.Nil(t, k.BindProxyHash(ctx, stranger, strangerProxy, 2, []byte{0x02}))
+	require.Nil(t, k.BindAssetHash(ctx, stranger, strangerProxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.AssetDecimals{}))
+	require.Error(t, k.Unlock(ctx, 2, []byte{0x02}, strangerProxy, sink.Bytes()))
+	require.Equal(t, sdk.NewInt(40), k.GetProxyBalance(ctx, lockproxy.UnassignedProxyHash, "lpcoin"))
+	require.False(t, k.IsUnassignedClaimant(ctx, strangerProxy, "lpcoin"))
+
+	// a proxy takes the coins it escrowed first and the unassigned ones then
+	k.SetProxyBalance(ctx, proxy, "lpcoin", sdk.NewInt(5))
+	k.SetProxyBalance(ctx, lockproxy.UnassignedProxyHash, "lpcoin", sdk.NewInt(35))
+	unlockArgs := func(amount int64) []byte {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := lockproxy.TxArgs{ToAssetHash: []byte("lpcoin"), ToAddress: receiver, Amount: big.NewInt(amount)}
+		require.Nil(t, args.Serialization(sink, 32))
+		return sink.Bytes()
+	}
+	require.Nil(t, k.Unlock(ctx, 2, []byte{0x02}, proxy, unlockArgs(30)))
+	require.Equal(t, sdk.ZeroInt(), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+	require.Equal(t, sdk.NewInt(10), k.GetProxyBalance(ctx, lockproxy.UnassignedProxyHash, "lpcoin"))
+	require.Error(t, k.Unlock(ctx, 2, []byte{0x02}, otherProxy, unlockArgs(30)))
+	require.Nil(t, k.Unlock(ctx, 2, []byte{0x02}, otherProxy, unlockArgs(10)))
+	require.Equal(t, sdk.ZeroInt(), k.GetProxyBalance(ctx, lockproxy.UnassignedProxyHash, "lpcoin"))
+	_, broken = lockproxy.ProxyBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the claimants are dropped with the unassigned coins they drew
+	claimants, err = k.GetUnassignedClaimants(ctx)
+	require.Nil(t, err)
+	require.Empty(t, claimants)
+
+	// the holdings of a denom no proxy binds stay locked when many proxies exist
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("idlecoin", sdk.NewInt(50)), proxy))
+	k.SetProxyBalance(ctx, proxy, "idlecoin", sdk.ZeroInt())
+	require.Nil(t, k.MigrateProxyBalances(ctx))
+	require.Equal(t, sdk.NewInt(50), k.GetProxyBalance(ctx, lockproxy.UnassignedProxyHash, "idlecoin"))
+	require.False(t, k.IsUnassignedClaimant(ctx, proxy, "idlecoin"))
+}
+
+func Test_lockproxy_UnlockReceiver(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy_operator"))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, ""))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(100)), proxy))
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x02}))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.AssetDecimals{}))
+
+	unlockTo := func(receiver []byte) error {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := lockproxy.TxArgs{ToAssetHash: []byte("lpcoin"), ToAddress: receiver, Amount: big.NewInt(10)}
+		require.Nil(t, args.Serialization(sink, 32))
+		return k.Unlock(ctx, 2, []byte{0x02}, proxy, sink.Bytes())
+	}
+
+	// the receiver never seen before is created by the unlock
+	receiver := sdk.AccAddress([]byte("lockproxy_receiver"))
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, receiver))
+	require.Nil(t, unlockTo(receiver))
+	require.Equal(t, sdk.NewInt(10), app.AccountKeeper.GetAccount(ctx, receiver).GetCoins().AmountOf("lpcoin"))
+
+	// the blacklisted and the module accounts receive nothing, not even the lockproxy module account
+	other := supply.NewEmptyModuleAccount("lockproxy_other")
+	app.AccountKeeper.SetAccount(ctx, other)
+	for _, blocked := range []sdk.AccAddress{
+		app.SupplyKeeper.GetModuleAddress(lockproxy.ModuleName),
+		app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName),
+		other.GetAddress(),
+		nil,
+	} {
+		require.Error(t, unlockTo(blocked), blocked.String())
+	}
+	require.Equal(t, sdk.NewInt(90), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+}
+
+func Test_lockproxy_ListingQueries(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	k := app.LockProxyKeeper
+	querier := lockproxy.NewQuerier(k)
+
+	operator := sdk.AccAddress([]byte("lockproxy-operator20"))
+	for _, salt := range []string{"a", "b", "c"} {
+		require.Nil(t, k.CreateLockProxy(ctx, operator, salt))
+	}
+	proxy := lockproxy.GetLockProxyHash(operator, "a")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("coina", sdk.NewInt(1)), proxy))
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("coinb", sdk.NewInt(1)), proxy))
+	for _, chainId := range []uint64{2, 3, 4} {
+		require.Nil(t, k.BindProxyHash(ctx, operator, proxy, chainId, []byte{byte(chainId)}))
+		require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "coina", chainId, []byte{0x0a, byte(chainId)}, lockproxy.AssetDecimals{}))
+	}
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "coinb", 2, []byte{0x0b, 0x02}, lockproxy.AssetDecimals{}))
+	// the bindings of another proxy are never listed with the ones of proxy
+	other := lockproxy.GetLockProxyHash(operator, "b")
+	require.Nil(t, k.BindProxyHash(ctx, operator, other, 2, []byte{0x02}))
+	require.Nil(t, k.BindAssetHash(ctx, operator, other, "coina", 2, []byte{0x0a, 0x02}, lockproxy.AssetDecimals{}))
+
+	query := func(path string, params interface{}, res interface{}) error {
+		bz, err := querier(ctx, []string{path}, abci.RequestQuery{Data: lockproxy.ModuleCdc.MustMarshalJSON(params)})
+		if err != nil {
+			return err
+		}
+		lockproxy.ModuleCdc.MustUnmarshalJSON(bz, res)
+		return nil
+	}
+
+	var proxies []lockproxy.LockProxy
+	require.Nil(t, query(lockproxy.QueryLockProxies, lockproxy.NewQueryLockProxiesParam(1, 0), &proxies))
+	require.Len(t, proxies, 3)
+	var page []lockproxy.LockProxy
+	require.Nil(t, query(lockproxy.QueryLockProxies, lockproxy.NewQueryLockProxiesParam(2, 2), &page))
+	require.Equal(t, proxies[2:], page)
+	require.Nil(t, query(lockproxy.QueryLockProxies, lockproxy.NewQueryLockProxiesParam(3, 2), &page))
+	require.Empty(t, page)
+	require.Error(t, query(lockproxy.QueryLockProxies, lockproxy.NewQueryLockProxiesParam(0, 2), &page))
+
+	var proxyBindings []lockproxy.ProxyBinding
+	require.Nil(t, query(lockproxy.QueryProxyBindings, lockproxy.NewQueryProxyBindingsParam(proxy, 1, 2), &proxyBindings))
+	require.Equal(t, []lockproxy.ProxyBinding{
+		{ProxyHash: proxy, ToChainId: 2, ToProxyHash: []byte{2}},
+		{ProxyHash: proxy, ToChainId: 3, ToProxyHash: []byte{3}},
+	}, proxyBindings)
+	require.Nil(t, query(lockproxy.QueryProxyBindings, lockproxy.NewQueryProxyBindingsParam(proxy, 2, 2), &proxyBindings))
+	require.Equal(t, []lockproxy.ProxyBinding{{ProxyHash: proxy, ToChainId: 4, ToProxyHash: []byte{4}}}, proxyBindings)
+
+	var assetBindings []lockproxy.AssetBinding
+	noLimits := lockproxy.NewLockLimits(sdk.ZeroInt(), sdk.ZeroInt())
+	require.Nil(t, query(lockproxy.QueryAssetBindings, lockproxy.NewQueryAssetBindingsParam(proxy, "coina", 1, 0), &assetBindings))
+	require.Len(t, assetBindings, 3)
+	for i, binding := range assetBindings {
+		require.Equal(t, lockproxy.AssetBinding{ProxyHash: proxy, SourceAssetDenom: "coina", ToChainId: uint64(i + 2), ToAssetHash: []byte{0x0a, byte(i + 2)}, Limits: noLimits}, binding)
+	}
+	require.Nil(t, query(lockproxy.QueryAssetBindings, lockproxy.NewQueryAssetBindingsParam(proxy, "", 2, 3), &assetBindings))
+	require.Equal(t, []lockproxy.AssetBinding{{ProxyHash: proxy, SourceAssetDenom: "coinb", ToChainId: 2, ToAssetHash: []byte{0x0b, 0x02}, Limits: noLimits}}, assetBindings)
+}
+
+func Test_lockproxy_AssetDecimals(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{ccm.NewChainInfo(2, "chain2", ccm.VMFamilyEVM, 0, ccm.AddressEncodingRaw, 32, true)})
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy_operator"))
+	user := sdk.AccAddress([]byte("lockproxy_user"))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, ""))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(100)), proxy))
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x02}))
+	require.Error(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.NewAssetDecimals(lockproxy.MaxAssetDecimals+1, 6)))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.NewAssetDecimals(8, 6)))
+	require.Equal(t, lockproxy.NewAssetDecimals(8, 6), k.GetAssetDecimals(ctx, proxy, "lpcoin", 2))
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+	_, err := app.BankKeeper.AddCoins(ctx, user, sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(1000))))
+	require.Nil(t, err)
+
+	// the dust below the 6 decimals of the bound asset stays with the sender
+	toAddress := bytes.Repeat([]byte{1}, 20)
+	require.Error(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(99)))
+	require.Nil(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(250)))
+	require.Equal(t, sdk.NewInt(800), app.BankKeeper.GetCoins(ctx, user).AmountOf("lpcoin"))
+	require.Equal(t, sdk.NewInt(300), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+
+	unlock := func(amount int64) error {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := lockproxy.TxArgs{ToAssetHash: []byte("lpcoin"), ToAddress: user, Amount: big.NewInt(amount)}
+		require.Nil(t, args.Serialization(sink, 32))
+		return k.Unlock(ctx, 2, []byte{0x02}, proxy, sink.Bytes())
+	}
+	require.Nil(t, unlock(1))
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetCoins(ctx, user).AmountOf("lpcoin"))
+	// 3 units of the bound asset scale to 300 of lpcoin, beyond what the proxy escrows
+	require.Error(t, unlock(3))
+
+	// the dust of the bound asset with more decimals than the denom is left out of the unlock
+	require.Nil(t, k.UnbindAssetHash(ctx, operator, proxy, "lpcoin", 2))
+	require.Equal(t, lockproxy.AssetDecimals{}, k.GetAssetDecimals(ctx, proxy, "lpcoin", 2))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.NewAssetDecimals(6, 8)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, unlock(150))
+	require.Equal(t, sdk.NewInt(901), app.BankKeeper.GetCoins(ctx, user).AmountOf("lpcoin"))
+	require.Equal(t, sdk.NewInt(199), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+	events := ctx.EventManager().Events()
+	require.Equal(t, lockproxy.EventTypeUnlock, events[len(events)-1].Type)
+	attributes := make(map[string]string)
+	for _, attribute := range events[len(events)-1].Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	require.Equal(t, "1", attributes[lockproxy.AttributeKeyAmount])
+	require.Equal(t, "150", attributes[lockproxy.AttributeKeyFromAmount])
+	require.Equal(t, "50", attributes[lockproxy.AttributeKeyDust])
+	require.Nil(t, unlock(100))
+	require.Equal(t, sdk.NewInt(902), app.BankKeeper.GetCoins(ctx, user).AmountOf("lpcoin"))
+	require.Equal(t, sdk.NewInt(198), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+
+	// an amount below the smallest unit of the denom unlocks nothing
+	require.Nil(t, unlock(99))
+	require.Equal(t, sdk.NewInt(902), app.BankKeeper.GetCoins(ctx, user).AmountOf("lpcoin"))
+	require.Equal(t, sdk.NewInt(198), k.GetProxyBalance(ctx, proxy, "lpcoin"))
+}
+
+func Test_lockproxy_LockLimits(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	app.CcmKeeper.SetChainRegistry(ctx, []ccm.ChainInfo{ccm.NewChainInfo(2, "chain2", ccm.VMFamilyEVM, 0, ccm.AddressEncodingRaw, 32, true)})
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy-operator20"))
+	user := sdk.AccAddress([]byte("lockproxy_user"))
+	require.Nil(t, k.CreateLockProxy(ctx, operator, ""))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.Nil(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(100)), proxy))
+	require.Nil(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x02}))
+
+	limits := lockproxy.NewLockLimits(sdk.NewInt(10), sdk.NewInt(300))
+	require.Error(t, k.UpdateLockLimits(ctx, operator, proxy, "lpcoin", 2, limits))
+	require.Nil(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte("lpcoin"), lockproxy.NewAssetDecimals(2, 1)))
+	require.Error(t, k.UpdateLockLimits(ctx, user, proxy, "lpcoin", 2, limits))
+	require.Error(t, k.UpdateLockLimits(ctx, operator, proxy, "lpcoin", 2, lockproxy.NewLockLimits(sdk.NewInt(300), sdk.NewInt(10))))
+	require.Nil(t, k.UpdateLockLimits(ctx, operator, proxy, "lpcoin", 2, limits))
+	require.Equal(t, limits, k.GetLockLimits(ctx, proxy, "lpcoin", 2))
+
+	// the asset-hash query returns the lock limits together with the binding
+	bz, err := lockproxy.NewQuerier(k)(ctx, []string{lockproxy.QueryAssetHash}, abci.RequestQuery{Data: lockproxy.ModuleCdc.MustMarshalJSON(lockproxy.NewQueryAssetHashParam(proxy, "lpcoin", 2))})
+	require.Nil(t, err)
+	var binding lockproxy.AssetBinding
+	lockproxy.ModuleCdc.MustUnmarshalJSON(bz, &binding)
+	require.Equal(t, []byte("lpcoin"), binding.ToAssetHash)
+	require.Equal(t, lockproxy.NewAssetDecimals(2, 1), binding.Decimals)
+	require.True(t, limits.MinAmount.Equal(binding.Limits.MinAmount) && limits.MaxAmount.Equal(binding.Limits.MaxAmount))
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+	_, err = app.BankKeeper.AddCoins(ctx, user, sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(1000))))
+	require.Nil(t, err)
+	toAddress := bytes.Repeat([]byte{1}, 20)
+	// the limits bound the escrowed amount, which leaves out the dust
+	require.Error(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(310)))
+	require.Nil(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(309)))
+	require.Nil(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(10)))
+	require.Equal(t, sdk.NewInt(690), app.BankKeeper.GetCoins(ctx, user).AmountOf("lpcoin"))
+
+	// zero leaves a side unbounded, and the unbinding drops the limits
+	require.Nil(t, k.UpdateLockLimits(ctx, operator, proxy, "lpcoin", 2, lockproxy.NewLockLimits(sdk.NewInt(100), sdk.ZeroInt())))
+	require.Error(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(90)))
+	require.Nil(t, k.Lock(ctx, proxy, user, "lpcoin", 2, toAddress, sdk.NewInt(500)))
+	require.Nil(t, k.UnbindAssetHash(ctx, operator, proxy, "lpcoin", 2))
+	require.True(t, k.GetLockLimits(ctx, proxy, "lpcoin", 2).IsEmpty())
+}
@@ -19,6 +19,8 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
@@ -33,20 +35,187 @@ var (
 	OperatorToLockProxyKey = []byte{0x01}
 	BindProxyPrefix        = []byte{0x02}
 	BindAssetPrefix        = []byte{0x03}
+	LockedBalancePrefix    = []byte{0x04}
+
+	PendingProxyBindingPrefix = []byte{0x05}
+	PendingAssetBindingPrefix = []byte{0x06}
+	PendingBindingQueuePrefix = []byte{0x07}
+
+	LockProxyOwnerPrefix        = []byte{0x08}
+	LockProxyPendingOwnerPrefix = []byte{0x09}
+
+	ProxyBalancePrefix  = []byte{0x0a}
+	AssetDecimalsPrefix = []byte{0x0b}
+	LockLimitsPrefix    = []byte{0x0c}
+
+	UnassignedClaimantPrefix = []byte{0x0d}
 )
 
-func GetOperatorToLockProxyKey(operator sdk.AccAddress) []byte {
-	return append(OperatorToLockProxyKey, operator...)
+// GetOperatorToLockProxyKey indexes the lock proxy proxyHash by its operator, which may operate many lock proxies
+func GetOperatorToLockProxyKey(operator sdk.AccAddress, proxyHash []byte) []byte {
+	return append(GetOperatorToLockProxiesPrefix(operator), proxyHash...)
+}
+
+// GetOperatorToLockProxiesPrefix is the prefix of the lock proxies operated by operator
+func GetOperatorToLockProxiesPrefix(operator sdk.AccAddress) []byte {
+	return append(append(OperatorToLockProxyKey, byte(len(operator))), operator...)
 }
 
+// GetLockProxyOwnerKey is the key of the owner of the lock proxy proxyHash, which tells the created lock proxies
+func GetLockProxyOwnerKey(proxyHash []byte) []byte {
+	return append(LockProxyOwnerPrefix, proxyHash...)
+}
+
+// GetLockProxyPendingOwnerKey is the key of the owner the lock proxy proxyHash is being transferred to
+func GetLockProxyPendingOwnerKey(proxyHash []byte) []byte {
+	return append(LockProxyPendingOwnerPrefix, proxyHash...)
+}
+
+// GetBindProxyKey length prefixes proxyHash so that the bindings of a proxy never mix with the ones of another proxy
 func GetBindProxyKey(proxyHash []byte, toChainId uint64) []byte {
 	b := make([]byte, 8)
-	binary.LittleEndian.PutUint64(b, toChainId)
-	return append(append(BindProxyPrefix, proxyHash...), b...)
+	binary.BigEndian.PutUint64(b, toChainId)
+	return append(append(append(BindProxyPrefix, byte(len(proxyHash))), proxyHash...), b...)
+}
+
+// GetAssetDecimalsKey lays out the decimals of the asset bindings as GetBindAssetHashKey lays out the asset bindings
+func GetAssetDecimalsKey(lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) []byte {
+	return append(AssetDecimalsPrefix, GetBindAssetHashKey(lockProxyHash, []byte(sourceAssetDenom), toChainId)[len(BindAssetPrefix):]...)
+}
+
+// GetLockLimitsKey lays out the lock limits of the asset bindings as GetBindAssetHashKey lays out the asset bindings
+func GetLockLimitsKey(lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) []byte {
+	return append(LockLimitsPrefix, GetBindAssetHashKey(lockProxyHash, []byte(sourceAssetDenom), toChainId)[len(BindAssetPrefix):]...)
+}
+
+// GetProxyBindingsPrefix is the prefix of the proxy bindings of proxyHash
+func GetProxyBindingsPrefix(proxyHash []byte) []byte {
+	return append(append(BindProxyPrefix, byte(len(proxyHash))), proxyHash...)
+}
+
+// GetAssetBindingsPrefix is the prefix of the asset bindings of sourceAssetDenom by lockProxyHash, or of all the
+// asset bindings of lockProxyHash when sourceAssetDenom is empty
+func GetAssetBindingsPrefix(lockProxyHash []byte, sourceAssetDenom string) []byte {
+	prefix := append(append(BindAssetPrefix, byte(len(lockProxyHash))), lockProxyHash...)
+	if sourceAssetDenom == "" {
+		return prefix
+	}
+	return append(append(prefix, byte(len(sourceAssetDenom))), sourceAssetDenom...)
 }
 
 func GetBindAssetHashKey(lockProxyHash []byte, sourceAssetHash []byte, targetChainId uint64) []byte {
 	b := make([]byte, 8)
-	binary.LittleEndian.PutUint64(b, targetChainId)
-	return append(append(append(BindAssetPrefix, lockProxyHash...), sourceAssetHash...), b...)
+	binary.BigEndian.PutUint64(b, targetChainId)
+	key := append(append(BindAssetPrefix, byte(len(lockProxyHash))), lockProxyHash...)
+	key = append(append(key, byte(len(sourceAssetHash))), sourceAssetHash...)
+	return append(key, b...)
+}
+
+// GetLockedBalanceKey length prefixes denom so that the balances of a denom never mix with the ones of another denom
+func GetLockedBalanceKey(denom string, chainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, chainId)
+	return append(append(append(LockedBalancePrefix, byte(len(denom))), denom...), b...)
+}
+
+// SplitLockedBalanceKey returns the denom and the chain id a key built by GetLockedBalanceKey is made of
+func SplitLockedBalanceKey(key []byte) (denom string, chainId uint64, err error) {
+	key = key[len(LockedBalancePrefix):]
+	if len(key) < 1 || len(key) != 1+int(key[0])+8 {
+		return "", 0, fmt.Errorf("locked balance key: %x is malformed", key)
+	}
+	return string(key[1 : 1+key[0]]), binary.BigEndian.Uint64(key[1+key[0]:]), nil
+}
+
+// GetProxyBalanceKey length prefixes proxyHash so that the balances of a proxy never mix with the ones of another proxy
+func GetProxyBalanceKey(proxyHash []byte, denom string) []byte {
+	return append(GetProxyBalancesPrefix(proxyHash), denom...)
+}
+
+// GetProxyBalancesPrefix is the prefix of the balances escrowed by the lock proxy proxyHash
+func GetProxyBalancesPrefix(proxyHash []byte) []byte {
+	return append(append(ProxyBalancePrefix, byte(len(proxyHash))), proxyHash...)
+}
+
+// SplitProxyBalanceKey returns the proxy hash and the denom a key built by GetProxyBalanceKey is made of
+func SplitProxyBalanceKey(key []byte) (proxyHash []byte, denom string, err error) {
+	key = key[len(ProxyBalancePrefix):]
+	if len(key) < 1 || len(key) <= 1+int(key[0]) {
+		return nil, "", fmt.Errorf("proxy balance key: %x is malformed", key)
+	}
+	return key[1 : 1+key[0]], string(key[1+key[0]:]), nil
+}
+
+// GetUnassignedClaimantKey length prefixes denom so that the claimants of a denom never mix with the ones of another denom
+func GetUnassignedClaimantKey(denom string, proxyHash []byte) []byte {
+	return append(GetUnassignedClaimantsPrefix(denom), proxyHash...)
+}
+
+// GetUnassignedClaimantsPrefix is the prefix of the lock proxies which may unlock the unassigned coins of denom
+func GetUnassignedClaimantsPrefix(denom string) []byte {
+	return append(append(UnassignedClaimantPrefix, byte(len(denom))), denom...)
+}
+
+// SplitUnassignedClaimantKey returns the denom and the proxy hash a key built by GetUnassignedClaimantKey is made of
+func SplitUnassignedClaimantKey(key []byte) (denom string, proxyHash []byte, err error) {
+	key = key[len(UnassignedClaimantPrefix):]
+	if len(key) < 1 || len(key) <= 1+int(key[0]) {
+		return "", nil, fmt.Errorf("unassigned claimant key: %x is malformed", key)
+	}
+	return string(key[1 : 1+key[0]]), key[1+key[0]:], nil
+}
+
+// SplitBindProxyKey returns the proxy hash and the chain id a key built by GetBindProxyKey is made of
+func SplitBindProxyKey(key []byte) (proxyHash []byte, toChainId uint64, err error) {
+	key = key[len(BindProxyPrefix):]
+	if len(key) < 1 || len(key) != 1+int(key[0])+8 {
+		return nil, 0, fmt.Errorf("proxy binding key: %x is malformed", key)
+	}
+	return key[1 : 1+key[0]], binary.BigEndian.Uint64(key[1+key[0]:]), nil
+}
+
+// SplitBindAssetHashKey returns the lock proxy hash, the source asset hash and the chain id a key built by
+// GetBindAssetHashKey is made of
+func SplitBindAssetHashKey(key []byte) (lockProxyHash []byte, sourceAssetHash []byte, targetChainId uint64, err error) {
+	key = key[len(BindAssetPrefix):]
+	if len(key) < 1 || len(key) < 1+int(key[0])+1 {
+		return nil, nil, 0, fmt.Errorf("asset binding key: %x is malformed", key)
+	}
+	lockProxyHash, rest := key[1:1+key[0]], key[1+key[0]:]
+	if len(rest) != 1+int(rest[0])+8 {
+		return nil, nil, 0, fmt.Errorf("asset binding key: %x is malformed", key)
+	}
+	return lockProxyHash, rest[1 : 1+rest[0]], binary.BigEndian.Uint64(rest[1+rest[0]:]), nil
+}
+
+// GetPendingProxyBindingKey lays out the pending proxy bindings as GetBindProxyKey lays out the proxy bindings
+func GetPendingProxyBindingKey(proxyHash []byte, toChainId uint64) []byte {
+	return append(PendingProxyBindingPrefix, GetBindProxyKey(proxyHash, toChainId)[len(BindProxyPrefix):]...)
+}
+
+// GetPendingProxyBindingsPrefix is the prefix of the pending proxy bindings of proxyHash
+func GetPendingProxyBindingsPrefix(proxyHash []byte) []byte {
+	return append(append(PendingProxyBindingPrefix, byte(len(proxyHash))), proxyHash...)
+}
+
+// GetPendingAssetBindingKey lays out the pending asset bindings as GetBindAssetHashKey lays out the asset bindings
+func GetPendingAssetBindingKey(lockProxyHash []byte, sourceAssetHash []byte, toChainId uint64) []byte {
+	return append(PendingAssetBindingPrefix, GetBindAssetHashKey(lockProxyHash, sourceAssetHash, toChainId)[len(BindAssetPrefix):]...)
+}
+
+// GetPendingAssetBindingsPrefix is the prefix of the pending asset bindings of lockProxyHash
+func GetPendingAssetBindingsPrefix(lockProxyHash []byte) []byte {
+	return append(append(PendingAssetBindingPrefix, byte(len(lockProxyHash))), lockProxyHash...)
+}
+
+// GetPendingBindingQueuePrefix is the prefix of the pending bindings taking effect at height
+func GetPendingBindingQueuePrefix(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(PendingBindingQueuePrefix, b...)
+}
+
+// GetPendingBindingQueueKey indexes the pending binding stored at pendingKey by the height it takes effect at
+func GetPendingBindingQueueKey(height int64, pendingKey []byte) []byte {
+	return append(GetPendingBindingQueuePrefix(height), pendingKey...)
 }
@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// MigrateBindKeys rewrites the proxy and asset bindings from the layout of consensus version 1, where the variable
+// length hashes were concatenated with little endian chain ids, into the length prefixed layout of version 2.
+// The lock proxy hash of an asset binding is told apart from the asset hash by matching the created lock proxies
+func (k Keeper) MigrateBindKeys(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	var proxies [][]byte
+	iter := sdk.KVStorePrefixIterator(store, OperatorToLockProxyKey)
+	for ; iter.Valid(); iter.Next() {
+		proxies = append(proxies, iter.Value())
+	}
+	iter.Close()
+
+	type binding struct {
+		oldKey, newKey, value []byte
+	}
+	var bindings []binding
+
+	iter = sdk.KVStorePrefixIterator(store, BindProxyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(BindProxyPrefix):]
+		if len(key) < 8 {
+			iter.Close()
+			return fmt.Errorf("proxy binding key: %x is too short", key)
+		}
+		proxyHash, toChainId := key[:len(key)-8], binary.LittleEndian.Uint64(key[len(key)-8:])
+		bindings = append(bindings, binding{iter.Key(), GetBindProxyKey(proxyHash, toChainId), iter.Value()})
+	}
+	iter.Close()
+
+	iter = sdk.KVStorePrefixIterator(store, BindAssetPrefix)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(BindAssetPrefix):]
+		if len(key) < 8 {
+			iter.Close()
+			return fmt.Errorf("asset binding key: %x is too short", key)
+		}
+		hashes, toChainId := key[:len(key)-8], binary.LittleEndian.Uint64(key[len(key)-8:])
+		var proxyHash []byte
+		for _, proxy := range proxies {
+			if len(proxy) > len(proxyHash) && bytes.HasPrefix(hashes, proxy) {
+				proxyHash = proxy
+			}
+		}
+		if proxyHash == nil {
+			iter.Close()
+			return fmt.Errorf("asset binding key: %x matches no lock proxy", key)
+		}
+		bindings = append(bindings, binding{iter.Key(), GetBindAssetHashKey(proxyHash, hashes[len(proxyHash):], toChainId), iter.Value()})
+	}
+	iter.Close()
+
+	for _, b := range bindings {
+		store.Delete(b.oldKey)
+	}
+	for _, b := range bindings {
+		store.Set(b.newKey, b.value)
+	}
+	return nil
+}
+
+// MigrateLockedBalances starts the locked balance accounting of consensus version 3: the coins escrowed before it
+// are not known to be locked for any chain, so the module account holdings are all accounted as unassigned
+func (k Keeper) MigrateLockedBalances(ctx sdk.Context) error {
+	for _, coin := range k.GetModuleAccount(ctx).GetCoins() {
+		k.SetLockedBalance(ctx, coin.Denom, types.UnassignedChainId, coin.Amount)
+	}
+	return nil
+}
+
+// MigrateLockProxyOwners records the owner of the lock proxies of consensus version 3, which is the operator
+// they were created by, so that their ownership can be transferred without changing their hash
+func (k Keeper) MigrateLockProxyOwners(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, OperatorToLockProxyKey)
+	var proxies []types.LockProxy
+	for ; iter.Valid(); iter.Next() {
+		proxies = append(proxies, types.LockProxy{Operator: sdk.AccAddress(iter.Key()[len(OperatorToLockProxyKey):]), ProxyHash: iter.Value()})
+	}
+	iter.Close()
+
+	for _, proxy := range proxies {
+		store.Set(GetLockProxyOwnerKey(proxy.ProxyHash), proxy.Operator.Bytes())
+	}
+	return nil
+}
+
+// MigrateOperatorIndex rewrites the operator index of consensus version 4, which kept the single lock proxy of an
+// operator under the operator, into the layout of version 5 indexing each lock proxy under its operator and hash
+func (k Keeper) MigrateOperatorIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, OperatorToLockProxyKey)
+	var proxies []types.LockProxy
+	var oldKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		oldKeys = append(oldKeys, iter.Key())
+		proxies = append(proxies, types.LockProxy{Operator: sdk.AccAddress(iter.Key()[len(OperatorToLockProxyKey):]), ProxyHash: iter.Value()})
+	}
+	iter.Close()
+
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+	for _, proxy := range proxies {
+		store.Set(GetOperatorToLockProxyKey(proxy.Operator, proxy.ProxyHash), proxy.ProxyHash)
+	}
+	return nil
+}
+
+// MigrateProxyBalances starts the per lock proxy escrow of consensus version 6. The coins escrowed before it were
+// not recorded per lock proxy, so the module account holdings of a denom are all assigned to the single lock proxy
+// binding the denom, or to the single lock proxy created when no lock proxy binds it. The holdings of a denom which
+// can not be told apart this way are accounted under types.UnassignedProxyHash, where only the unlocks of the lock
+// proxies binding the denom at the migration draw from, the holdings of a denom no lock proxy binds stay locked
+func (k Keeper) MigrateProxyBalances(ctx sdk.Context) error {
+	proxies := k.GetLockProxies(ctx)
+	assetBindings, err := k.GetAssetBindings(ctx)
+	if err != nil {
+		return err
+	}
+	binders := make(map[string][][]byte)
+	bind := func(proxyHash []byte, denom string) {
+		for _, binder := range binders[denom] {
+			if bytes.Equal(binder, proxyHash) {
+				return
+			}
+		}
+		binders[denom] = append(binders[denom], proxyHash)
+	}
+	for _, binding := range assetBindings {
+		bind(binding.ProxyHash, binding.SourceAssetDenom)
+	}
+	for _, binding := range k.GetAllPendingBindings(ctx).AssetBindings {
+		bind(binding.ProxyHash, binding.SourceAssetDenom)
+	}
+
+	for _, coin := range k.GetModuleAccount(ctx).GetCoins() {
+		switch {
+		case len(binders[coin.Denom]) == 1:
+			k.SetProxyBalance(ctx, binders[coin.Denom][0], coin.Denom, coin.Amount)
+		case len(binders[coin.Denom]) == 0 && len(proxies) == 1:
+			k.SetProxyBalance(ctx, proxies[0].ProxyHash, coin.Denom, coin.Amount)
+		default:
+			k.SetProxyBalance(ctx, types.UnassignedProxyHash, coin.Denom, coin.Amount)
+			for _, binder := range binders[coin.Denom] {
+				k.SetUnassignedClaimant(ctx, binder, coin.Denom)
+			}
+		}
+	}
+	return nil
+}
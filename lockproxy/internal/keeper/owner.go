@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// GetLockProxyOwner returns the operator owning the lock proxy proxyHash, empty if it has not been created
+func (k Keeper) GetLockProxyOwner(ctx sdk.Context, proxyHash []byte) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(GetLockProxyOwnerKey(proxyHash))
+}
+
+// GetPendingLockProxyOwner returns the owner the lock proxy proxyHash is being transferred to, empty if there is
+// no pending transfer
+func (k Keeper) GetPendingLockProxyOwner(ctx sdk.Context, proxyHash []byte) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(GetLockProxyPendingOwnerKey(proxyHash))
+}
+
+// IsLockProxyOwner returns true if operator owns the created lock proxy proxyHash
+func (k Keeper) IsLockProxyOwner(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte) bool {
+	owner := k.GetLockProxyOwner(ctx, proxyHash)
+	return !owner.Empty() && bytes.Equal(owner, operator)
+}
+
+// TransferLockProxyOwnership proposes newOwner as the operator of the lock proxy proxyHash owned by owner, proposing
+// owner itself cancels the pending transfer. The proxy hash is kept so that the counterpart contracts on other
+// chains stay bound
+func (k Keeper) TransferLockProxyOwnership(ctx sdk.Context, owner sdk.AccAddress, proxyHash []byte, newOwner sdk.AccAddress) error {
+	if !k.IsLockProxyOwner(ctx, owner, proxyHash) {
+		return types.ErrLockProxyOwnership(fmt.Sprintf("owner:%s does NOT operate lockproxy contract: %x", owner.String(), proxyHash))
+	}
+	if bytes.Equal(owner, newOwner) {
+		k.SetPendingLockProxyOwner(ctx, proxyHash, nil)
+	} else {
+		k.SetPendingLockProxyOwner(ctx, proxyHash, newOwner)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferLockProxyOwnership,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		),
+	)
+	return nil
+}
+
+// AcceptLockProxyOwnership makes newOwner the operator of the lock proxy proxyHash, provided it is the owner
+// proposed by the current one
+func (k Keeper) AcceptLockProxyOwnership(ctx sdk.Context, newOwner sdk.AccAddress, proxyHash []byte) error {
+	pendingOwner := k.GetPendingLockProxyOwner(ctx, proxyHash)
+	if len(pendingOwner) == 0 {
+		return types.ErrLockProxyOwnership(fmt.Sprintf("lockproxy: %x has no pending ownership transfer", proxyHash))
+	}
+	if !bytes.Equal(pendingOwner, newOwner) {
+		return types.ErrLockProxyOwnership(fmt.Sprintf("new owner is not valid, expect: %s, got: %s", pendingOwner.String(), newOwner.String()))
+	}
+	previousOwner := k.GetLockProxyOwner(ctx, proxyHash)
+	ctx.KVStore(k.storeKey).Delete(GetOperatorToLockProxyKey(previousOwner, proxyHash))
+	k.SetLockProxy(ctx, newOwner, proxyHash)
+	k.SetPendingLockProxyOwner(ctx, proxyHash, nil)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptLockProxyOwnership,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		),
+	)
+	return nil
+}
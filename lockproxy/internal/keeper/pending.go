@@ -0,0 +1,242 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// SetPendingProxyBinding schedules binding, replacing the pending binding of the same proxy and chain if any
+func (k Keeper) SetPendingProxyBinding(ctx sdk.Context, binding types.PendingProxyBinding) {
+	k.deletePendingProxyBinding(ctx, binding.ProxyHash, binding.ToChainId)
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingProxyBindingKey(binding.ProxyHash, binding.ToChainId)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(binding))
+	store.Set(GetPendingBindingQueueKey(binding.EffectiveHeight, key), []byte{})
+}
+
+// GetPendingProxyBinding returns the binding of proxyHash to toChainId waiting for the bind delay, if any
+func (k Keeper) GetPendingProxyBinding(ctx sdk.Context, proxyHash []byte, toChainId uint64) (binding types.PendingProxyBinding, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetPendingProxyBindingKey(proxyHash, toChainId))
+	if bz == nil {
+		return binding, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &binding)
+	return binding, true
+}
+
+func (k Keeper) deletePendingProxyBinding(ctx sdk.Context, proxyHash []byte, toChainId uint64) bool {
+	binding, found := k.GetPendingProxyBinding(ctx, proxyHash, toChainId)
+	if !found {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingProxyBindingKey(proxyHash, toChainId)
+	store.Delete(GetPendingBindingQueueKey(binding.EffectiveHeight, key))
+	store.Delete(key)
+	return true
+}
+
+// SetPendingAssetBinding schedules binding, replacing the pending binding of the same proxy, denom and chain if any
+func (k Keeper) SetPendingAssetBinding(ctx sdk.Context, binding types.PendingAssetBinding) {
+	k.deletePendingAssetBinding(ctx, binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId)
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingAssetBindingKey(binding.ProxyHash, []byte(binding.SourceAssetDenom), binding.ToChainId)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(binding))
+	store.Set(GetPendingBindingQueueKey(binding.EffectiveHeight, key), []byte{})
+}
+
+// GetPendingAssetBinding returns the binding of sourceAssetDenom to toChainId by lockProxyHash waiting for the
+// bind delay, if any
+func (k Keeper) GetPendingAssetBinding(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) (binding types.PendingAssetBinding, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetPendingAssetBindingKey(lockProxyHash, []byte(sourceAssetDenom), toChainId))
+	if bz == nil {
+		return binding, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &binding)
+	return binding, true
+}
+
+func (k Keeper) deletePendingAssetBinding(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) bool {
+	binding, found := k.GetPendingAssetBinding(ctx, lockProxyHash, sourceAssetDenom, toChainId)
+	if !found {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingAssetBindingKey(lockProxyHash, []byte(sourceAssetDenom), toChainId)
+	store.Delete(GetPendingBindingQueueKey(binding.EffectiveHeight, key))
+	store.Delete(key)
+	return true
+}
+
+// CancelPendingProxyBinding drops the binding of the lock proxy proxyHash of operator to toChainId waiting for the
+// bind delay
+func (k Keeper) CancelPendingProxyBinding(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, toChainId uint64) error {
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrCancelPendingBinding(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
+	}
+	if !k.deletePendingProxyBinding(ctx, proxyHash, toChainId) {
+		return types.ErrCancelPendingBinding(fmt.Sprintf("lockproxy: %x has no pending proxy binding to chainId: %d", proxyHash, toChainId))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelPendingBindProxy,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+		),
+	})
+	return nil
+}
+
+// CancelPendingAssetBinding drops the binding of sourceAssetDenom to toChainId by the lock proxy proxyHash of operator
+// waiting for the bind delay
+func (k Keeper) CancelPendingAssetBinding(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, sourceAssetDenom string, toChainId uint64) error {
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrCancelPendingBinding(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
+	}
+	if !k.deletePendingAssetBinding(ctx, proxyHash, sourceAssetDenom, toChainId) {
+		return types.ErrCancelPendingBinding(fmt.Sprintf("lockproxy: %x has no pending asset binding of denom: %s to chainId: %d", proxyHash, sourceAssetDenom, toChainId))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelPendingBindAsset,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+		),
+	})
+	return nil
+}
+
+// UnbindProxyHash removes the binding of the lock proxy proxyHash of operator to toChainId at once, together with the
+// binding waiting for the bind delay, so that the unlocks from toChainId stop until it is bound again
+func (k Keeper) UnbindProxyHash(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, toChainId uint64) error {
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrUnbind(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
+	}
+	bound := k.GetProxyHash(ctx, proxyHash, toChainId) != nil
+	if pending := k.deletePendingProxyBinding(ctx, proxyHash, toChainId); !bound && !pending {
+		return types.ErrUnbind(fmt.Sprintf("lockproxy: %x is not bound to chainId: %d", proxyHash, toChainId))
+	}
+	ctx.KVStore(k.storeKey).Delete(GetBindProxyKey(proxyHash, toChainId))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbindProxy,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+		),
+	})
+	return nil
+}
+
+// UnbindAssetHash removes the binding of sourceAssetDenom to toChainId by the lock proxy proxyHash of operator at once,
+// together with the binding waiting for the bind delay
+func (k Keeper) UnbindAssetHash(ctx sdk.Context, operator sdk.AccAddress, proxyHash []byte, sourceAssetDenom string, toChainId uint64) error {
+	if !k.IsLockProxyOwner(ctx, operator, proxyHash) {
+		return types.ErrUnbind(fmt.Sprintf("operator:%s does NOT operate lockproxy contract: %x", operator.String(), proxyHash))
+	}
+	bound := k.GetAssetHash(ctx, proxyHash, sourceAssetDenom, toChainId) != nil
+	if pending := k.deletePendingAssetBinding(ctx, proxyHash, sourceAssetDenom, toChainId); !bound && !pending {
+		return types.ErrUnbind(fmt.Sprintf("denom: %s of lockproxy: %x is not bound to chainId: %d", sourceAssetDenom, proxyHash, toChainId))
+	}
+	ctx.KVStore(k.storeKey).Delete(GetBindAssetHashKey(proxyHash, []byte(sourceAssetDenom), toChainId))
+	k.SetAssetDecimals(ctx, proxyHash, sourceAssetDenom, toChainId, types.AssetDecimals{})
+	k.SetLockLimits(ctx, proxyHash, sourceAssetDenom, toChainId, types.LockLimits{})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbindAsset,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(proxyHash)),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+		),
+	})
+	return nil
+}
+
+// GetPendingBindings returns the bindings of lockProxyHash waiting for the bind delay
+func (k Keeper) GetPendingBindings(ctx sdk.Context, lockProxyHash []byte) types.PendingBindings {
+	return types.PendingBindings{
+		ProxyBindings: k.getPendingProxyBindings(ctx, GetPendingProxyBindingsPrefix(lockProxyHash)),
+		AssetBindings: k.getPendingAssetBindings(ctx, GetPendingAssetBindingsPrefix(lockProxyHash)),
+	}
+}
+
+// GetAllPendingBindings returns the bindings of all the lock proxies waiting for the bind delay
+func (k Keeper) GetAllPendingBindings(ctx sdk.Context) types.PendingBindings {
+	return types.PendingBindings{
+		ProxyBindings: k.getPendingProxyBindings(ctx, PendingProxyBindingPrefix),
+		AssetBindings: k.getPendingAssetBindings(ctx, PendingAssetBindingPrefix),
+	}
+}
+
+func (k Keeper) getPendingProxyBindings(ctx sdk.Context, prefix []byte) []types.PendingProxyBinding {
+	bindings := []types.PendingProxyBinding{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var binding types.PendingProxyBinding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &binding)
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
+
+func (k Keeper) getPendingAssetBindings(ctx sdk.Context, prefix []byte) []types.PendingAssetBinding {
+	bindings := []types.PendingAssetBinding{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var binding types.PendingAssetBinding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &binding)
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
+
+// ApplyPendingBindings lets the pending bindings whose effective height is reached take effect
+func (k Keeper) ApplyPendingBindings(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(PendingBindingQueuePrefix, GetPendingBindingQueuePrefix(ctx.BlockHeight()+1))
+	var pendingKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pendingKeys = append(pendingKeys, iter.Key()[len(PendingBindingQueuePrefix)+8:])
+	}
+	iter.Close()
+
+	for _, key := range pendingKeys {
+		bz := store.Get(key)
+		switch key[0] {
+		case PendingProxyBindingPrefix[0]:
+			var binding types.PendingProxyBinding
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &binding)
+			k.deletePendingProxyBinding(ctx, binding.ProxyHash, binding.ToChainId)
+			k.applyProxyBinding(ctx, binding.ProxyHash, binding.ToChainId, binding.ToProxyHash)
+		case PendingAssetBindingPrefix[0]:
+			var binding types.PendingAssetBinding
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &binding)
+			k.deletePendingAssetBinding(ctx, binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId)
+			k.applyAssetBinding(ctx, binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId, binding.ToAssetHash, binding.Decimals)
+		}
+	}
+}
@@ -18,6 +18,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -37,6 +39,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxyHash(ctx, req, k)
 		case types.QueryAssetHash:
 			return queryAssetHash(ctx, req, k)
+		case types.QueryLockedBalance:
+			return queryLockedBalance(ctx, req, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		case types.QueryPendingBindings:
+			return queryPendingBindings(ctx, req, k)
+		case types.QueryLockProxy:
+			return queryLockProxy(ctx, req, k)
+		case types.QueryProxyBalance:
+			return queryProxyBalance(ctx, req, k)
+		case types.QueryLockProxies:
+			return queryLockProxies(ctx, req, k)
+		case types.QueryProxyBindings:
+			return queryProxyBindings(ctx, req, k)
+		case types.QueryAssetBindings:
+			return queryAssetBindings(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -49,13 +67,10 @@ func queryProxyByOperator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]b
 	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
 	}
-	proxyHash := k.GetLockProxyByOperator(ctx, params.Operator)
-	//if proxyHash == nil {
-	//	return nil, sdk.ErrInternal(fmt.Sprintf("queryProxyByOperator, operator:%s havenot created lockproxy contract before", params.Operator.String()))
-	//}
-	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, proxyHash)
+	proxies := k.GetLockProxiesByOperator(ctx, params.Operator)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, proxies)
 	if e != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal proxyHash: %x to JSON", proxyHash)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal lockProxies of operator: %s to JSON", params.Operator.String())
 	}
 
 	return bz, nil
@@ -76,19 +91,176 @@ func queryProxyHash(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	return bz, nil
 }
 
+// queryAssetHash returns the asset binding of the denom to the chain, together with its decimals and lock limits
 func queryAssetHash(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryAssetHashParam
 
 	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
 	}
-	assetHashBs := k.GetAssetHash(ctx, params.LockProxyHash, params.SourceAssetDenom, params.ChainId)
-	//if assetHashBs == nil {
-	//	return nil, sdk.ErrInternal(fmt.Sprintf("queryAssetHash, there is no toChainAssetHash with chainId:%d correlated with sourceAssetDenom:%s in lockproxy contract:%x", params.ChainId, params.SourceAssetDenom, params.LockProxyHash))
-	//}
-	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, assetHashBs)
+	binding := types.AssetBinding{
+		ProxyHash:        params.LockProxyHash,
+		SourceAssetDenom: params.SourceAssetDenom,
+		ToChainId:        params.ChainId,
+		ToAssetHash:      k.GetAssetHash(ctx, params.LockProxyHash, params.SourceAssetDenom, params.ChainId),
+		Decimals:         k.GetAssetDecimals(ctx, params.LockProxyHash, params.SourceAssetDenom, params.ChainId),
+		Limits:           k.GetLockLimits(ctx, params.LockProxyHash, params.SourceAssetDenom, params.ChainId),
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, binding)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal toChain assetHash: %x of denom: %s and chainId: %d in lockProxy: %x to JSON", binding.ToAssetHash, params.SourceAssetDenom, params.ChainId, params.LockProxyHash)
+	}
+
+	return bz, nil
+}
+
+func queryLockedBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLockedBalanceParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	balance := types.NewLockedBalance(params.Denom, params.ChainId, k.GetLockedBalance(ctx, params.Denom, params.ChainId))
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balance)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal locked balance: %s to JSON", balance.String())
+	}
+
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal params: %s to JSON", params.String())
+	}
+
+	return bz, nil
+}
+
+func queryPendingBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPendingBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	bindings := k.GetPendingBindings(ctx, params.LockProxyHash)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal pending bindings of lockProxy: %x to JSON", params.LockProxyHash)
+	}
+
+	return bz, nil
+}
+
+func queryLockProxy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLockProxyParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	owner := k.GetLockProxyOwner(ctx, params.LockProxyHash)
+	if owner.Empty() {
+		return nil, types.ErrLockProxyOwnership(fmt.Sprintf("lockproxy: %x is not created", params.LockProxyHash))
+	}
+	proxy := types.LockProxy{Operator: owner, ProxyHash: params.LockProxyHash, PendingOwner: k.GetPendingLockProxyOwner(ctx, params.LockProxyHash)}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, proxy)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal lockProxy: %x to JSON", params.LockProxyHash)
+	}
+
+	return bz, nil
+}
+
+func queryProxyBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProxyBalanceParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	coins := k.GetProxyCoins(ctx, params.LockProxyHash)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal balance: %s of lockProxy: %x to JSON", coins.String(), params.LockProxyHash)
+	}
+
+	return bz, nil
+}
+
+// pageOffset returns the offset and the limit of the page-th page of limit entries, pages start from 1
+func pageOffset(page, limit int) (int, int, error) {
+	if page < 1 {
+		return 0, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "page: %d must be greater than 0", page)
+	}
+	if limit < 0 {
+		return 0, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "limit: %d must not be negative", limit)
+	}
+	if limit == 0 {
+		limit = types.DefaultQueryLimit
+	}
+	return (page - 1) * limit, limit, nil
+}
+
+func queryLockProxies(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLockProxiesParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	offset, limit, err := pageOffset(params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	proxies := k.GetLockProxiesPage(ctx, offset, limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, proxies)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal page: %d of lockProxies to JSON", params.Page)
+	}
+
+	return bz, nil
+}
+
+func queryProxyBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProxyBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	offset, limit, err := pageOffset(params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := k.GetProxyBindingsByProxy(ctx, params.LockProxyHash, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal proxy bindings of lockProxy: %x to JSON", params.LockProxyHash)
+	}
+
+	return bz, nil
+}
+
+func queryAssetBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAssetBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	offset, limit, err := pageOffset(params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := k.GetAssetBindingsByProxy(ctx, params.LockProxyHash, params.SourceAssetDenom, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal toChain assetHash: %x of denom: %s and chainId: %d in lockProxy: %x to JSON", assetHashBs, params.SourceAssetDenom, params.ChainId, params.LockProxyHash)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal asset bindings of denom: %s of lockProxy: %x to JSON", params.SourceAssetDenom, params.LockProxyHash)
 	}
 
 	return bz, nil
@@ -0,0 +1,105 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnassignedChainId is the chain id the escrowed coins locked for no chain are accounted under, like the coins
+// created through CreateCoinAndDelegateToProxy. They back the unlocks from any chain once the coins locked for
+// the source chain run out
+const UnassignedChainId uint64 = 0
+
+// LockedBalance is the amount of Denom escrowed in the module account for the chain ChainId
+type LockedBalance struct {
+	Denom   string  `json:"denom" yaml:"denom"`
+	ChainId uint64  `json:"chain_id" yaml:"chain_id"`
+	Amount  sdk.Int `json:"amount" yaml:"amount"`
+}
+
+func NewLockedBalance(denom string, chainId uint64, amount sdk.Int) LockedBalance {
+	return LockedBalance{Denom: denom, ChainId: chainId, Amount: amount}
+}
+
+func (b LockedBalance) String() string {
+	return fmt.Sprintf("%s%s/%d", b.Amount.String(), b.Denom, b.ChainId)
+}
+
+// SumLockedBalances returns the coins the locked balances add up to
+func SumLockedBalances(balances []LockedBalance) sdk.Coins {
+	sum := sdk.NewCoins()
+	for _, balance := range balances {
+		sum = sum.Add(sdk.NewCoin(balance.Denom, balance.Amount))
+	}
+	return sum
+}
+
+// UnassignedProxyHash is the empty proxy hash the escrowed coins of no known lock proxy are accounted under, like
+// the coins escrowed before the per lock proxy escrow whose denom was bound by many lock proxies. They back the
+// unlocks of the UnassignedClaimants of their denom once the coins escrowed by the lock proxy run out, and stay
+// locked when their denom has no claimant
+var UnassignedProxyHash = []byte{}
+
+// ProxyBalance is the amount of Denom escrowed in the module account by the lock proxy ProxyHash, which is all the
+// lock proxy may unlock of Denom
+type ProxyBalance struct {
+	ProxyHash []byte  `json:"proxy_hash" yaml:"proxy_hash"`
+	Denom     string  `json:"denom" yaml:"denom"`
+	Amount    sdk.Int `json:"amount" yaml:"amount"`
+}
+
+func NewProxyBalance(proxyHash []byte, denom string, amount sdk.Int) ProxyBalance {
+	return ProxyBalance{ProxyHash: proxyHash, Denom: denom, Amount: amount}
+}
+
+func (b ProxyBalance) String() string {
+	return fmt.Sprintf("%s%s/%x", b.Amount.String(), b.Denom, b.ProxyHash)
+}
+
+// SumProxyBalances returns the coins the proxy balances add up to
+func SumProxyBalances(balances []ProxyBalance) sdk.Coins {
+	sum := sdk.NewCoins()
+	for _, balance := range balances {
+		sum = sum.Add(sdk.NewCoin(balance.Denom, balance.Amount))
+	}
+	return sum
+}
+
+// UnassignedClaimant is a lock proxy which bound Denom when its escrowed coins were accounted under
+// UnassignedProxyHash, only the claimants of a denom may unlock its unassigned coins
+type UnassignedClaimant struct {
+	ProxyHash []byte `json:"proxy_hash" yaml:"proxy_hash"`
+	Denom     string `json:"denom" yaml:"denom"`
+}
+
+func NewUnassignedClaimant(proxyHash []byte, denom string) UnassignedClaimant {
+	return UnassignedClaimant{ProxyHash: proxyHash, Denom: denom}
+}
+
+func (c UnassignedClaimant) String() string {
+	return fmt.Sprintf("%s/%x", c.Denom, c.ProxyHash)
+}
+
+// CoinsEqual tells if a and b hold the same amount of every denom, unlike sdk.Coins.IsEqual it does not panic when
+// they hold different denoms
+func CoinsEqual(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
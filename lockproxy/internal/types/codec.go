@@ -30,6 +30,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBindProxyHash{}, ModuleName+"/MsgBindProxyHash", nil)
 	cdc.RegisterConcrete(MsgBindAssetHash{}, ModuleName+"/MsgBindAssetHash", nil)
 	cdc.RegisterConcrete(MsgLock{}, ModuleName+"/MsgLock", nil)
+	cdc.RegisterConcrete(MsgUnbindProxyHash{}, ModuleName+"/MsgUnbindProxyHash", nil)
+	cdc.RegisterConcrete(MsgUnbindAssetHash{}, ModuleName+"/MsgUnbindAssetHash", nil)
+	cdc.RegisterConcrete(MsgCancelPendingProxyBinding{}, ModuleName+"/MsgCancelPendingProxyBinding", nil)
+	cdc.RegisterConcrete(MsgCancelPendingAssetBinding{}, ModuleName+"/MsgCancelPendingAssetBinding", nil)
+	cdc.RegisterConcrete(MsgTransferLockProxyOwnership{}, ModuleName+"/MsgTransferLockProxyOwnership", nil)
+	cdc.RegisterConcrete(MsgAcceptLockProxyOwnership{}, ModuleName+"/MsgAcceptLockProxyOwnership", nil)
+	cdc.RegisterConcrete(MsgUpdateLockLimits{}, ModuleName+"/MsgUpdateLockLimits", nil)
 }
 
 func init() {
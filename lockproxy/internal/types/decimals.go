@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAssetDecimals bounds the decimals of the denoms and of the assets of the other chains they are bound to
+const MaxAssetDecimals uint32 = 36
+
+// AssetDecimals are the decimals of a denom and of the asset of another chain it is bound to, the amounts crossing
+// the chains are scaled by their difference. The zero value, like any equal decimals, leaves the amounts as they are
+type AssetDecimals struct {
+	SourceDecimals uint32 `json:"source_decimals" yaml:"source_decimals"`
+	ToDecimals     uint32 `json:"to_decimals" yaml:"to_decimals"`
+}
+
+func NewAssetDecimals(sourceDecimals, toDecimals uint32) AssetDecimals {
+	return AssetDecimals{SourceDecimals: sourceDecimals, ToDecimals: toDecimals}
+}
+
+func (d AssetDecimals) String() string {
+	return fmt.Sprintf("%d/%d", d.SourceDecimals, d.ToDecimals)
+}
+
+// Validate checks both decimals are within MaxAssetDecimals
+func (d AssetDecimals) Validate() error {
+	if d.SourceDecimals > MaxAssetDecimals || d.ToDecimals > MaxAssetDecimals {
+		return fmt.Errorf("asset decimals: %s exceed the maximum: %d", d.String(), MaxAssetDecimals)
+	}
+	return nil
+}
+
+// ToChainAmount scales amount of the denom into the amount of the bound asset, dust is the part of amount too small
+// to be represented with the decimals of the bound asset, which is not sent
+func (d AssetDecimals) ToChainAmount(amount sdk.Int) (converted sdk.Int, dust sdk.Int, err error) {
+	if d.ToDecimals >= d.SourceDecimals {
+		converted, err := scale(amount, d.ToDecimals-d.SourceDecimals)
+		return converted, sdk.ZeroInt(), err
+	}
+	factor := pow10(d.SourceDecimals - d.ToDecimals)
+	quo, rem := new(big.Int).QuoRem(amount.BigInt(), factor, new(big.Int))
+	return sdk.NewIntFromBigInt(quo), sdk.NewIntFromBigInt(rem), nil
+}
+
+// FromChainAmount scales amount of the bound asset back into the amount of the denom, dust is the part of amount too
+// small to be represented with the decimals of the denom, which is not unlocked as it was escrowed on the other chain
+func (d AssetDecimals) FromChainAmount(amount sdk.Int) (converted sdk.Int, dust sdk.Int, err error) {
+	if d.SourceDecimals >= d.ToDecimals {
+		converted, err := scale(amount, d.SourceDecimals-d.ToDecimals)
+		return converted, sdk.ZeroInt(), err
+	}
+	factor := pow10(d.ToDecimals - d.SourceDecimals)
+	quo, rem := new(big.Int).QuoRem(amount.BigInt(), factor, new(big.Int))
+	return sdk.NewIntFromBigInt(quo), sdk.NewIntFromBigInt(rem), nil
+}
+
+func scale(amount sdk.Int, decimals uint32) (sdk.Int, error) {
+	scaled := new(big.Int).Mul(amount.BigInt(), pow10(decimals))
+	if scaled.BitLen() > 255 {
+		return sdk.Int{}, fmt.Errorf("amount: %s scaled by %d decimals overflows", amount.String(), decimals)
+	}
+	return sdk.NewIntFromBigInt(scaled), nil
+}
+
+func pow10(exp uint32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
@@ -35,6 +35,11 @@ var (
 	ErrUnLockType                       = sdkerrors.Register(ModuleName, 9, "ErrUnLockType")
 	ErrMsgBindProxyHashType             = sdkerrors.Register(ModuleName, 10, "ErrMsgBindProxyHashType")
 	ErrCreateCoinAndDelegateToProxyType = sdkerrors.Register(ModuleName, 11, "ErrCreateCoinAndDelegateToProxyType")
+	ErrLockedBalanceType                = sdkerrors.Register(ModuleName, 12, "ErrLockedBalanceType")
+	ErrUnbindType                       = sdkerrors.Register(ModuleName, 13, "ErrUnbindType")
+	ErrCancelPendingBindingType         = sdkerrors.Register(ModuleName, 14, "ErrCancelPendingBindingType")
+	ErrLockProxyOwnershipType           = sdkerrors.Register(ModuleName, 15, "ErrLockProxyOwnershipType")
+	ErrLockLimitsType                   = sdkerrors.Register(ModuleName, 16, "ErrLockLimitsType")
 )
 
 func ErrInvalidChainId(chainId uint64) error {
@@ -78,3 +83,23 @@ func ErrMsgBindProxyHash(reason string) error {
 func ErrCreateCoinAndDelegateToProxy(reason string) error {
 	return sdkerrors.Wrapf(ErrCreateCoinAndDelegateToProxyType, fmt.Sprintf("Reason: %s", reason))
 }
+
+func ErrLockedBalance(reason string) error {
+	return sdkerrors.Wrapf(ErrLockedBalanceType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrUnbind(reason string) error {
+	return sdkerrors.Wrapf(ErrUnbindType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrCancelPendingBinding(reason string) error {
+	return sdkerrors.Wrapf(ErrCancelPendingBindingType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrLockProxyOwnership(reason string) error {
+	return sdkerrors.Wrapf(ErrLockProxyOwnershipType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrLockLimits(reason string) error {
+	return sdkerrors.Wrapf(ErrLockLimitsType, fmt.Sprintf("Reason: %s", reason))
+}
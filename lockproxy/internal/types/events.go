@@ -24,6 +24,15 @@ const (
 	EventTypeCreateAndDelegateCoinToProxy = "create_and_delegate_coin_to_proxy"
 	EventTypeBindProxy                    = "bind_proxy_hash"
 	EventTypeBindAsset                    = "bind_asset_hash"
+	EventTypePendingBindProxy             = "pending_bind_proxy_hash"
+	EventTypePendingBindAsset             = "pending_bind_asset_hash"
+	EventTypeCancelPendingBindProxy       = "cancel_pending_bind_proxy_hash"
+	EventTypeCancelPendingBindAsset       = "cancel_pending_bind_asset_hash"
+	EventTypeUnbindProxy                  = "unbind_proxy_hash"
+	EventTypeUnbindAsset                  = "unbind_asset_hash"
+	EventTypeTransferLockProxyOwnership   = "transfer_lock_proxy_ownership"
+	EventTypeAcceptLockProxyOwnership     = "accept_lock_proxy_ownership"
+	EventTypeUpdateLockLimits             = "update_lock_limits"
 	EventTypeLock                         = "lock"
 	EventTypeUnlock                       = "unlock"
 	AttributeKeyCreator                   = "creator"
@@ -36,4 +45,16 @@ const (
 	AttributeKeyFromAddress               = "from_address"
 	AttributeKeyToAddress                 = "to_address"
 	AttributeKeyAmount                    = "amount"
+	AttributeKeyEffectiveHeight           = "effective_height"
+	AttributeKeyOwner                     = "owner"
+	AttributeKeyNewOwner                  = "new_owner"
+	AttributeKeyPreviousOwner             = "previous_owner"
+	AttributeKeySalt                      = "salt"
+	AttributeKeySourceDecimals            = "source_decimals"
+	AttributeKeyToDecimals                = "to_decimals"
+	AttributeKeyToAmount                  = "to_amount"
+	AttributeKeyDust                      = "dust"
+	AttributeKeyFromAmount                = "from_amount"
+	AttributeKeyMinAmount                 = "min_amount"
+	AttributeKeyMaxAmount                 = "max_amount"
 )
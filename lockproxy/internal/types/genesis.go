@@ -0,0 +1,276 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockProxy is the lock proxy contract owned by Operator, ProxyHash is the hash it is known by on the other chains,
+// PendingOwner is the owner it is being transferred to, if any
+type LockProxy struct {
+	Operator     sdk.AccAddress `json:"operator" yaml:"operator"`
+	ProxyHash    []byte         `json:"proxy_hash" yaml:"proxy_hash"`
+	PendingOwner sdk.AccAddress `json:"pending_owner" yaml:"pending_owner"`
+}
+
+// ProxyBinding is the lock proxy contract ToProxyHash of the chain ToChainId the lock proxy ProxyHash is bound to
+type ProxyBinding struct {
+	ProxyHash   []byte `json:"proxy_hash" yaml:"proxy_hash"`
+	ToChainId   uint64 `json:"to_chain_id" yaml:"to_chain_id"`
+	ToProxyHash []byte `json:"to_proxy_hash" yaml:"to_proxy_hash"`
+}
+
+// AssetBinding is the asset ToAssetHash of the chain ToChainId SourceAssetDenom is bound to by the lock proxy ProxyHash,
+// the amounts crossing the chains are scaled by Decimals and a single lock sends an amount within Limits
+type AssetBinding struct {
+	ProxyHash        []byte        `json:"proxy_hash" yaml:"proxy_hash"`
+	SourceAssetDenom string        `json:"source_asset_denom" yaml:"source_asset_denom"`
+	ToChainId        uint64        `json:"to_chain_id" yaml:"to_chain_id"`
+	ToAssetHash      []byte        `json:"to_asset_hash" yaml:"to_asset_hash"`
+	Decimals         AssetDecimals `json:"decimals" yaml:"decimals"`
+	Limits           LockLimits    `json:"limits" yaml:"limits"`
+}
+
+// GenesisState - lockproxy state, ModuleBalance is the balance of the module account the locked coins are
+// escrowed in, which the imported module account, the LockedBalances and the ProxyBalances are checked against,
+// UnassignedClaimants are the lock proxies which may unlock the coins accounted under UnassignedProxyHash
+type GenesisState struct {
+	Params          Params          `json:"params" yaml:"params"`
+	LockProxies     []LockProxy     `json:"lock_proxies" yaml:"lock_proxies"`
+	ProxyBindings   []ProxyBinding  `json:"proxy_bindings" yaml:"proxy_bindings"`
+	AssetBindings   []AssetBinding  `json:"asset_bindings" yaml:"asset_bindings"`
+	PendingBindings PendingBindings `json:"pending_bindings" yaml:"pending_bindings"`
+	LockedBalances  []LockedBalance `json:"locked_balances" yaml:"locked_balances"`
+	ProxyBalances   []ProxyBalance  `json:"proxy_balances" yaml:"proxy_balances"`
+	ModuleBalance   sdk.Coins       `json:"module_balance" yaml:"module_balance"`
+
+	UnassignedClaimants []UnassignedClaimant `json:"unassigned_claimants" yaml:"unassigned_claimants"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, lockProxies []LockProxy, proxyBindings []ProxyBinding, assetBindings []AssetBinding, pendingBindings PendingBindings, lockedBalances []LockedBalance, proxyBalances []ProxyBalance, moduleBalance sdk.Coins, unassignedClaimants []UnassignedClaimant) GenesisState {
+	return GenesisState{
+		Params:          params,
+		LockProxies:     lockProxies,
+		ProxyBindings:   proxyBindings,
+		AssetBindings:   assetBindings,
+		PendingBindings: pendingBindings,
+		LockedBalances:  lockedBalances,
+		ProxyBalances:   proxyBalances,
+		ModuleBalance:   moduleBalance,
+
+		UnassignedClaimants: unassignedClaimants,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:          DefaultParams(),
+		LockProxies:     []LockProxy{},
+		ProxyBindings:   []ProxyBinding{},
+		AssetBindings:   []AssetBinding{},
+		PendingBindings: PendingBindings{ProxyBindings: []PendingProxyBinding{}, AssetBindings: []PendingAssetBinding{}},
+		LockedBalances:  []LockedBalance{},
+		ProxyBalances:   []ProxyBalance{},
+		ModuleBalance:   sdk.Coins{},
+
+		UnassignedClaimants: []UnassignedClaimant{},
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	proxies := make(map[string]bool, len(data.LockProxies))
+	for _, proxy := range data.LockProxies {
+		if proxy.Operator.Empty() {
+			return fmt.Errorf("lock proxy: %x has empty operator", proxy.ProxyHash)
+		}
+		if len(proxy.ProxyHash) == 0 {
+			return fmt.Errorf("lock proxy of operator: %s has empty proxy hash", proxy.Operator.String())
+		}
+		if proxies[hex.EncodeToString(proxy.ProxyHash)] {
+			return fmt.Errorf("lock proxy: %x is duplicated", proxy.ProxyHash)
+		}
+		if bytes.Equal(proxy.PendingOwner, proxy.Operator) {
+			return fmt.Errorf("lock proxy: %x is being transferred to its operator: %s", proxy.ProxyHash, proxy.Operator.String())
+		}
+		proxies[hex.EncodeToString(proxy.ProxyHash)] = true
+	}
+
+	proxyBindings := make(map[string]bool, len(data.ProxyBindings))
+	for _, binding := range data.ProxyBindings {
+		if !proxies[hex.EncodeToString(binding.ProxyHash)] {
+			return fmt.Errorf("proxy binding of lock proxy: %x refers to no lock proxy", binding.ProxyHash)
+		}
+		if binding.ToChainId == 0 {
+			return fmt.Errorf("proxy binding of lock proxy: %x has zero toChainId", binding.ProxyHash)
+		}
+		if len(binding.ToProxyHash) == 0 {
+			return fmt.Errorf("proxy binding of lock proxy: %x to chainId: %d has empty toProxyHash", binding.ProxyHash, binding.ToChainId)
+		}
+		key := fmt.Sprintf("%x/%d", binding.ProxyHash, binding.ToChainId)
+		if proxyBindings[key] {
+			return fmt.Errorf("proxy binding of lock proxy: %x to chainId: %d is duplicated", binding.ProxyHash, binding.ToChainId)
+		}
+		proxyBindings[key] = true
+	}
+
+	assetBindings := make(map[string]bool, len(data.AssetBindings))
+	for _, binding := range data.AssetBindings {
+		if !proxies[hex.EncodeToString(binding.ProxyHash)] {
+			return fmt.Errorf("asset binding of lock proxy: %x refers to no lock proxy", binding.ProxyHash)
+		}
+		if err := sdk.ValidateDenom(binding.SourceAssetDenom); err != nil {
+			return fmt.Errorf("asset binding of lock proxy: %x has invalid denom: %s", binding.ProxyHash, binding.SourceAssetDenom)
+		}
+		if binding.ToChainId == 0 {
+			return fmt.Errorf("asset binding of denom: %s of lock proxy: %x has zero toChainId", binding.SourceAssetDenom, binding.ProxyHash)
+		}
+		if len(binding.ToAssetHash) == 0 {
+			return fmt.Errorf("asset binding of denom: %s of lock proxy: %x to chainId: %d has empty toAssetHash", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId)
+		}
+		if err := binding.Decimals.Validate(); err != nil {
+			return fmt.Errorf("asset binding of denom: %s of lock proxy: %x to chainId: %d has invalid decimals: %s", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId, err.Error())
+		}
+		if err := binding.Limits.Validate(); err != nil {
+			return fmt.Errorf("asset binding of denom: %s of lock proxy: %x to chainId: %d has invalid lock limits: %s", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId, err.Error())
+		}
+		key := fmt.Sprintf("%x/%s/%d", binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId)
+		if assetBindings[key] {
+			return fmt.Errorf("asset binding of denom: %s of lock proxy: %x to chainId: %d is duplicated", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId)
+		}
+		assetBindings[key] = true
+	}
+
+	pendingProxyBindings := make(map[string]bool, len(data.PendingBindings.ProxyBindings))
+	for _, binding := range data.PendingBindings.ProxyBindings {
+		if !proxies[hex.EncodeToString(binding.ProxyHash)] {
+			return fmt.Errorf("pending proxy binding of lock proxy: %x refers to no lock proxy", binding.ProxyHash)
+		}
+		if binding.ToChainId == 0 {
+			return fmt.Errorf("pending proxy binding of lock proxy: %x has zero toChainId", binding.ProxyHash)
+		}
+		if len(binding.ToProxyHash) == 0 {
+			return fmt.Errorf("pending proxy binding of lock proxy: %x to chainId: %d has empty toProxyHash", binding.ProxyHash, binding.ToChainId)
+		}
+		if binding.EffectiveHeight <= 0 {
+			return fmt.Errorf("pending proxy binding of lock proxy: %x to chainId: %d has non-positive effective height: %d", binding.ProxyHash, binding.ToChainId, binding.EffectiveHeight)
+		}
+		key := fmt.Sprintf("%x/%d", binding.ProxyHash, binding.ToChainId)
+		if pendingProxyBindings[key] {
+			return fmt.Errorf("pending proxy binding of lock proxy: %x to chainId: %d is duplicated", binding.ProxyHash, binding.ToChainId)
+		}
+		pendingProxyBindings[key] = true
+	}
+
+	pendingAssetBindings := make(map[string]bool, len(data.PendingBindings.AssetBindings))
+	for _, binding := range data.PendingBindings.AssetBindings {
+		if !proxies[hex.EncodeToString(binding.ProxyHash)] {
+			return fmt.Errorf("pending asset binding of lock proxy: %x refers to no lock proxy", binding.ProxyHash)
+		}
+		if err := sdk.ValidateDenom(binding.SourceAssetDenom); err != nil {
+			return fmt.Errorf("pending asset binding of lock proxy: %x has invalid denom: %s", binding.ProxyHash, binding.SourceAssetDenom)
+		}
+		if binding.ToChainId == 0 {
+			return fmt.Errorf("pending asset binding of denom: %s of lock proxy: %x has zero toChainId", binding.SourceAssetDenom, binding.ProxyHash)
+		}
+		if len(binding.ToAssetHash) == 0 {
+			return fmt.Errorf("pending asset binding of denom: %s of lock proxy: %x to chainId: %d has empty toAssetHash", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId)
+		}
+		if err := binding.Decimals.Validate(); err != nil {
+			return fmt.Errorf("pending asset binding of denom: %s of lock proxy: %x to chainId: %d has invalid decimals: %s", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId, err.Error())
+		}
+		if binding.EffectiveHeight <= 0 {
+			return fmt.Errorf("pending asset binding of denom: %s of lock proxy: %x to chainId: %d has non-positive effective height: %d", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId, binding.EffectiveHeight)
+		}
+		key := fmt.Sprintf("%x/%s/%d", binding.ProxyHash, binding.SourceAssetDenom, binding.ToChainId)
+		if pendingAssetBindings[key] {
+			return fmt.Errorf("pending asset binding of denom: %s of lock proxy: %x to chainId: %d is duplicated", binding.SourceAssetDenom, binding.ProxyHash, binding.ToChainId)
+		}
+		pendingAssetBindings[key] = true
+	}
+
+	lockedBalances := make(map[string]bool, len(data.LockedBalances))
+	for _, balance := range data.LockedBalances {
+		if err := sdk.ValidateDenom(balance.Denom); err != nil {
+			return fmt.Errorf("locked balance: %s has invalid denom", balance.String())
+		}
+		if (balance.Amount == sdk.Int{}) || !balance.Amount.IsPositive() {
+			return fmt.Errorf("locked balance: %s is not positive", balance.String())
+		}
+		key := fmt.Sprintf("%s/%d", balance.Denom, balance.ChainId)
+		if lockedBalances[key] {
+			return fmt.Errorf("locked balance of denom: %s for chainId: %d is duplicated", balance.Denom, balance.ChainId)
+		}
+		lockedBalances[key] = true
+	}
+
+	proxyBalances := make(map[string]bool, len(data.ProxyBalances))
+	for _, balance := range data.ProxyBalances {
+		if len(balance.ProxyHash) != len(UnassignedProxyHash) && !proxies[hex.EncodeToString(balance.ProxyHash)] {
+			return fmt.Errorf("proxy balance: %s refers to no lock proxy", balance.String())
+		}
+		if err := sdk.ValidateDenom(balance.Denom); err != nil {
+			return fmt.Errorf("proxy balance: %s has invalid denom", balance.String())
+		}
+		if (balance.Amount == sdk.Int{}) || !balance.Amount.IsPositive() {
+			return fmt.Errorf("proxy balance: %s is not positive", balance.String())
+		}
+		key := fmt.Sprintf("%x/%s", balance.ProxyHash, balance.Denom)
+		if proxyBalances[key] {
+			return fmt.Errorf("balance of denom: %s escrowed by lock proxy: %x is duplicated", balance.Denom, balance.ProxyHash)
+		}
+		proxyBalances[key] = true
+	}
+
+	claimants := make(map[string]bool, len(data.UnassignedClaimants))
+	for _, claimant := range data.UnassignedClaimants {
+		if !proxies[hex.EncodeToString(claimant.ProxyHash)] {
+			return fmt.Errorf("unassigned claimant: %s refers to no lock proxy", claimant.String())
+		}
+		if !proxyBalances[fmt.Sprintf("%x/%s", UnassignedProxyHash, claimant.Denom)] {
+			return fmt.Errorf("unassigned claimant: %s claims no unassigned balance", claimant.String())
+		}
+		if claimants[claimant.String()] {
+			return fmt.Errorf("unassigned claimant: %s is duplicated", claimant.String())
+		}
+		claimants[claimant.String()] = true
+	}
+
+	if !data.ModuleBalance.IsValid() {
+		return fmt.Errorf("module balance: %s is invalid", data.ModuleBalance.String())
+	}
+	if sum := SumLockedBalances(data.LockedBalances); !CoinsEqual(sum, data.ModuleBalance) {
+		return fmt.Errorf("locked balances add up to: %s, not to the module balance: %s", sum.String(), data.ModuleBalance.String())
+	}
+	if sum := SumProxyBalances(data.ProxyBalances); !CoinsEqual(sum, data.ModuleBalance) {
+		return fmt.Errorf("proxy balances add up to: %s, not to the module balance: %s", sum.String(), data.ModuleBalance.String())
+	}
+	return nil
+}
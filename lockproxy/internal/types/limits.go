@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockLimits bound the amount of the denom a single lock sends through an asset binding, a zero or unset MinAmount
+// or MaxAmount leaves that side unbounded
+type LockLimits struct {
+	MinAmount sdk.Int `json:"min_amount" yaml:"min_amount"`
+	MaxAmount sdk.Int `json:"max_amount" yaml:"max_amount"`
+}
+
+func NewLockLimits(minAmount, maxAmount sdk.Int) LockLimits {
+	return LockLimits{MinAmount: minAmount, MaxAmount: maxAmount}
+}
+
+// Normalize replaces the unset amounts by zero
+func (l LockLimits) Normalize() LockLimits {
+	return NewLockLimits(orZero(l.MinAmount), orZero(l.MaxAmount))
+}
+
+// IsEmpty tells whether the limits bound neither side
+func (l LockLimits) IsEmpty() bool {
+	l = l.Normalize()
+	return l.MinAmount.IsZero() && l.MaxAmount.IsZero()
+}
+
+func (l LockLimits) String() string {
+	l = l.Normalize()
+	return fmt.Sprintf("%s/%s", l.MinAmount.String(), l.MaxAmount.String())
+}
+
+// Validate checks the amounts are not negative and MinAmount does not exceed a set MaxAmount
+func (l LockLimits) Validate() error {
+	l = l.Normalize()
+	if l.MinAmount.IsNegative() || l.MaxAmount.IsNegative() {
+		return fmt.Errorf("lock limits: %s are negative", l.String())
+	}
+	if l.MaxAmount.IsPositive() && l.MinAmount.GT(l.MaxAmount) {
+		return fmt.Errorf("lock limits: minimum amount: %s exceeds the maximum amount: %s", l.MinAmount.String(), l.MaxAmount.String())
+	}
+	return nil
+}
+
+// Check rejects the amount of a lock outside the limits
+func (l LockLimits) Check(amount sdk.Int) error {
+	l = l.Normalize()
+	if amount.LT(l.MinAmount) {
+		return fmt.Errorf("amount: %s is below the minimum lock amount: %s", amount.String(), l.MinAmount.String())
+	}
+	if l.MaxAmount.IsPositive() && amount.GT(l.MaxAmount) {
+		return fmt.Errorf("amount: %s exceeds the maximum lock amount: %s", amount.String(), l.MaxAmount.String())
+	}
+	return nil
+}
+
+func orZero(amount sdk.Int) sdk.Int {
+	if amount == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return amount
+}
@@ -32,16 +32,24 @@ const (
 	TypeMsgBindProxyHash                = "bind_proxy_hash"
 	TypeMsgBindAssetHash                = "bind_asset_hash"
 	TypeMsgLock                         = "lock"
+	TypeMsgUnbindProxyHash              = "unbind_proxy_hash"
+	TypeMsgUnbindAssetHash              = "unbind_asset_hash"
+	TypeMsgCancelPendingProxyBinding    = "cancel_pending_proxy_binding"
+	TypeMsgCancelPendingAssetBinding    = "cancel_pending_asset_binding"
+	TypeMsgTransferLockProxyOwnership   = "transfer_lock_proxy_ownership"
+	TypeMsgAcceptLockProxyOwnership     = "accept_lock_proxy_ownership"
+	TypeMsgUpdateLockLimits             = "update_lock_limits"
 )
 
-// MsgSend - high level transaction of the coin module
+// MsgCreateLockProxy creates a lock proxy operated by Creator, whose hash is derived from Creator and Salt
 type MsgCreateLockProxy struct {
 	Creator sdk.AccAddress
+	Salt    string
 }
 
 // NewMsgSend - construct arbitrary multi-in, multi-out send msg.
-func NewMsgCreateLockProxy(creator sdk.AccAddress) MsgCreateLockProxy {
-	return MsgCreateLockProxy{creator}
+func NewMsgCreateLockProxy(creator sdk.AccAddress, salt string) MsgCreateLockProxy {
+	return MsgCreateLockProxy{creator, salt}
 }
 
 // Route Implements Msg.
@@ -55,6 +63,9 @@ func (msg MsgCreateLockProxy) ValidateBasic() error {
 	if msg.Creator.Empty() {
 		return sdkerrors.ErrInvalidAddress
 	}
+	if len(msg.Salt) > MaxLockProxySaltLength {
+		return ErrCreateLockProxy(fmt.Sprintf("salt is longer than %d bytes", MaxLockProxySaltLength))
+	}
 	return nil
 }
 
@@ -70,6 +81,7 @@ func (msg MsgCreateLockProxy) GetSignBytes() []byte {
 
 type MsgBindProxyHash struct {
 	Operator         sdk.AccAddress
+	LockProxyHash    []byte
 	ToChainId        uint64
 	ToChainProxyHash []byte
 }
@@ -115,8 +127,8 @@ func (msg MsgCreateCoinAndDelegateToProxy) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
-func NewMsgBindProxyHash(operator sdk.AccAddress, toChainId uint64, toChainProxyHash []byte) MsgBindProxyHash {
-	return MsgBindProxyHash{operator, toChainId, toChainProxyHash}
+func NewMsgBindProxyHash(operator sdk.AccAddress, lockProxyHash []byte, toChainId uint64, toChainProxyHash []byte) MsgBindProxyHash {
+	return MsgBindProxyHash{operator, lockProxyHash, toChainId, toChainProxyHash}
 }
 
 //nolint
@@ -128,6 +140,9 @@ func (msg MsgBindProxyHash) ValidateBasic() error {
 	if msg.Operator.Empty() {
 		return sdkerrors.ErrInvalidAddress
 	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrMsgBindProxyHash("Empty MsgBindProxyHash.LockProxyHash")
+	}
 	if msg.ToChainId <= 0 {
 		return ErrInvalidChainId(msg.ToChainId)
 	}
@@ -144,9 +159,10 @@ func (msg MsgBindProxyHash) ValidateBasic() error {
 func (msg MsgBindProxyHash) String() string {
 	return fmt.Sprintf(`MsgBindProxyHash:
   Operator:       		%s(%x)
+  LockProxyHash:        %x
   ToChainId:			%d
   ToChainProxyHash:     %s
-`, msg.Operator.String(), msg.Operator.Bytes(), msg.ToChainId, hex.EncodeToString(msg.ToChainProxyHash))
+`, msg.Operator.String(), msg.Operator.Bytes(), msg.LockProxyHash, msg.ToChainId, hex.EncodeToString(msg.ToChainProxyHash))
 }
 
 // Implements Msg.
@@ -162,13 +178,15 @@ func (msg MsgBindProxyHash) GetSigners() []sdk.AccAddress {
 
 type MsgBindAssetHash struct {
 	Operator         sdk.AccAddress
+	LockProxyHash    []byte
 	SourceAssetDenom string
 	ToChainId        uint64
 	ToAssetHash      []byte
+	Decimals         AssetDecimals
 }
 
-func NewMsgBindAssetHash(operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) MsgBindAssetHash {
-	return MsgBindAssetHash{operator, sourceAssetDenom, toChainId, toAssetHash}
+func NewMsgBindAssetHash(operator sdk.AccAddress, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, decimals AssetDecimals) MsgBindAssetHash {
+	return MsgBindAssetHash{operator, lockProxyHash, sourceAssetDenom, toChainId, toAssetHash, decimals}
 }
 
 //nolint
@@ -180,6 +198,9 @@ func (msg MsgBindAssetHash) ValidateBasic() error {
 	if msg.Operator.Empty() {
 		return sdkerrors.ErrInvalidAddress
 	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrMsgBindAssetHash("Empty MsgBindAssetHash.LockProxyHash")
+	}
 	if msg.SourceAssetDenom == "" {
 		return ErrMsgBindAssetHash("Empty MsgBindAssetHash.SourceAssetDenom")
 	} else if _, err := sdk.ParseCoin("10" + msg.SourceAssetDenom); err != nil {
@@ -194,16 +215,20 @@ func (msg MsgBindAssetHash) ValidateBasic() error {
 		// handler is implemented.
 		return ErrMsgBindAssetHash("Empty MsgBindAssetHash.ToAssetHash")
 	}
+	if err := msg.Decimals.Validate(); err != nil {
+		return ErrMsgBindAssetHash(err.Error())
+	}
 	return nil
 }
 
 func (msg MsgBindAssetHash) String() string {
 	return fmt.Sprintf(`Bind Proxy Hash Message:
   Signer:         	%s
+  LockProxyHash:    %x
   SourceAssetDenom: %s
   ToChainId:  		%d
   ToAssetHash:      %s
-`, msg.Operator.String(), msg.SourceAssetDenom, msg.ToChainId, hex.EncodeToString(msg.ToAssetHash))
+`, msg.Operator.String(), msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId, hex.EncodeToString(msg.ToAssetHash))
 }
 
 // Implements Msg.
@@ -281,3 +306,361 @@ func (msg MsgLock) GetSignBytes() []byte {
 func (msg MsgLock) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+// MsgUnbindProxyHash removes the binding of the lock proxy LockProxyHash of Operator to ToChainId and its pending
+// rebinding
+type MsgUnbindProxyHash struct {
+	Operator      sdk.AccAddress
+	LockProxyHash []byte
+	ToChainId     uint64
+}
+
+func NewMsgUnbindProxyHash(operator sdk.AccAddress, lockProxyHash []byte, toChainId uint64) MsgUnbindProxyHash {
+	return MsgUnbindProxyHash{operator, lockProxyHash, toChainId}
+}
+
+//nolint
+func (msg MsgUnbindProxyHash) Route() string { return RouterKey }
+func (msg MsgUnbindProxyHash) Type() string  { return TypeMsgUnbindProxyHash }
+
+// Implements Msg.
+func (msg MsgUnbindProxyHash) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrUnbind("Empty MsgUnbindProxyHash.LockProxyHash")
+	}
+	if msg.ToChainId <= 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgUnbindProxyHash) String() string {
+	return fmt.Sprintf(`MsgUnbindProxyHash:
+  Operator:       		%s(%x)
+  LockProxyHash:        %x
+  ToChainId:			%d
+`, msg.Operator.String(), msg.Operator.Bytes(), msg.LockProxyHash, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgUnbindProxyHash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUnbindProxyHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgUnbindAssetHash removes the binding of SourceAssetDenom to ToChainId by the lock proxy LockProxyHash of
+// Operator and its pending rebinding
+type MsgUnbindAssetHash struct {
+	Operator         sdk.AccAddress
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ToChainId        uint64
+}
+
+func NewMsgUnbindAssetHash(operator sdk.AccAddress, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) MsgUnbindAssetHash {
+	return MsgUnbindAssetHash{operator, lockProxyHash, sourceAssetDenom, toChainId}
+}
+
+//nolint
+func (msg MsgUnbindAssetHash) Route() string { return RouterKey }
+func (msg MsgUnbindAssetHash) Type() string  { return TypeMsgUnbindAssetHash }
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrUnbind("Empty MsgUnbindAssetHash.LockProxyHash")
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("Invalid denom: %s", msg.SourceAssetDenom))
+	}
+	if msg.ToChainId <= 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgUnbindAssetHash) String() string {
+	return fmt.Sprintf(`MsgUnbindAssetHash:
+  Operator:         %s
+  LockProxyHash:    %x
+  SourceAssetDenom: %s
+  ToChainId:  		%d
+`, msg.Operator.String(), msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgCancelPendingProxyBinding drops the binding of the lock proxy LockProxyHash of Operator to ToChainId waiting
+// for the bind delay
+type MsgCancelPendingProxyBinding struct {
+	Operator      sdk.AccAddress
+	LockProxyHash []byte
+	ToChainId     uint64
+}
+
+func NewMsgCancelPendingProxyBinding(operator sdk.AccAddress, lockProxyHash []byte, toChainId uint64) MsgCancelPendingProxyBinding {
+	return MsgCancelPendingProxyBinding{operator, lockProxyHash, toChainId}
+}
+
+//nolint
+func (msg MsgCancelPendingProxyBinding) Route() string { return RouterKey }
+func (msg MsgCancelPendingProxyBinding) Type() string  { return TypeMsgCancelPendingProxyBinding }
+
+// Implements Msg.
+func (msg MsgCancelPendingProxyBinding) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrCancelPendingBinding("Empty MsgCancelPendingProxyBinding.LockProxyHash")
+	}
+	if msg.ToChainId <= 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgCancelPendingProxyBinding) String() string {
+	return fmt.Sprintf(`MsgCancelPendingProxyBinding:
+  Operator:       		%s(%x)
+  LockProxyHash:        %x
+  ToChainId:			%d
+`, msg.Operator.String(), msg.Operator.Bytes(), msg.LockProxyHash, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgCancelPendingProxyBinding) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgCancelPendingProxyBinding) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgCancelPendingAssetBinding drops the binding of SourceAssetDenom to ToChainId by the lock proxy LockProxyHash
+// of Operator waiting for the bind delay
+type MsgCancelPendingAssetBinding struct {
+	Operator         sdk.AccAddress
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ToChainId        uint64
+}
+
+func NewMsgCancelPendingAssetBinding(operator sdk.AccAddress, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) MsgCancelPendingAssetBinding {
+	return MsgCancelPendingAssetBinding{operator, lockProxyHash, sourceAssetDenom, toChainId}
+}
+
+//nolint
+func (msg MsgCancelPendingAssetBinding) Route() string { return RouterKey }
+func (msg MsgCancelPendingAssetBinding) Type() string  { return TypeMsgCancelPendingAssetBinding }
+
+// Implements Msg.
+func (msg MsgCancelPendingAssetBinding) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrCancelPendingBinding("Empty MsgCancelPendingAssetBinding.LockProxyHash")
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("Invalid denom: %s", msg.SourceAssetDenom))
+	}
+	if msg.ToChainId <= 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgCancelPendingAssetBinding) String() string {
+	return fmt.Sprintf(`MsgCancelPendingAssetBinding:
+  Operator:         %s
+  LockProxyHash:    %x
+  SourceAssetDenom: %s
+  ToChainId:  		%d
+`, msg.Operator.String(), msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgCancelPendingAssetBinding) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgCancelPendingAssetBinding) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgTransferLockProxyOwnership proposes NewOwner as the operator of the lock proxy LockProxyHash of Owner, the
+// transfer completes once NewOwner accepts it through MsgAcceptLockProxyOwnership. Proposing Owner itself cancels
+// the pending transfer
+type MsgTransferLockProxyOwnership struct {
+	Owner         sdk.AccAddress
+	LockProxyHash []byte
+	NewOwner      sdk.AccAddress
+}
+
+func NewMsgTransferLockProxyOwnership(owner sdk.AccAddress, lockProxyHash []byte, newOwner sdk.AccAddress) MsgTransferLockProxyOwnership {
+	return MsgTransferLockProxyOwnership{Owner: owner, LockProxyHash: lockProxyHash, NewOwner: newOwner}
+}
+
+//nolint
+func (msg MsgTransferLockProxyOwnership) Route() string { return RouterKey }
+func (msg MsgTransferLockProxyOwnership) Type() string  { return TypeMsgTransferLockProxyOwnership }
+
+// Implements Msg.
+func (msg MsgTransferLockProxyOwnership) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgTransferLockProxyOwnership.Owner is empty")
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrLockProxyOwnership("empty MsgTransferLockProxyOwnership.LockProxyHash")
+	}
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgTransferLockProxyOwnership.NewOwner is empty")
+	}
+	return nil
+}
+
+func (msg MsgTransferLockProxyOwnership) String() string {
+	return fmt.Sprintf(`MsgTransferLockProxyOwnership:
+  Owner:         		%s
+  LockProxyHash: 		%x
+  NewOwner:      		%s
+`, msg.Owner.String(), msg.LockProxyHash, msg.NewOwner.String())
+}
+
+// Implements Msg.
+func (msg MsgTransferLockProxyOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgTransferLockProxyOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgAcceptLockProxyOwnership makes NewOwner the operator of the lock proxy LockProxyHash being transferred to it
+type MsgAcceptLockProxyOwnership struct {
+	NewOwner      sdk.AccAddress
+	LockProxyHash []byte
+}
+
+func NewMsgAcceptLockProxyOwnership(newOwner sdk.AccAddress, lockProxyHash []byte) MsgAcceptLockProxyOwnership {
+	return MsgAcceptLockProxyOwnership{NewOwner: newOwner, LockProxyHash: lockProxyHash}
+}
+
+//nolint
+func (msg MsgAcceptLockProxyOwnership) Route() string { return RouterKey }
+func (msg MsgAcceptLockProxyOwnership) Type() string  { return TypeMsgAcceptLockProxyOwnership }
+
+// Implements Msg.
+func (msg MsgAcceptLockProxyOwnership) ValidateBasic() error {
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgAcceptLockProxyOwnership.NewOwner is empty")
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrLockProxyOwnership("empty MsgAcceptLockProxyOwnership.LockProxyHash")
+	}
+	return nil
+}
+
+func (msg MsgAcceptLockProxyOwnership) String() string {
+	return fmt.Sprintf(`MsgAcceptLockProxyOwnership:
+  NewOwner:      		%s
+  LockProxyHash: 		%x
+`, msg.NewOwner.String(), msg.LockProxyHash)
+}
+
+// Implements Msg.
+func (msg MsgAcceptLockProxyOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgAcceptLockProxyOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewOwner}
+}
+
+// MsgUpdateLockLimits bounds the amount of SourceAssetDenom a single lock sends to ToChainId through the lock proxy
+// LockProxyHash of Operator
+type MsgUpdateLockLimits struct {
+	Operator         sdk.AccAddress
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ToChainId        uint64
+	Limits           LockLimits
+}
+
+func NewMsgUpdateLockLimits(operator sdk.AccAddress, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64, limits LockLimits) MsgUpdateLockLimits {
+	return MsgUpdateLockLimits{Operator: operator, LockProxyHash: lockProxyHash, SourceAssetDenom: sourceAssetDenom, ToChainId: toChainId, Limits: limits}
+}
+
+//nolint
+func (msg MsgUpdateLockLimits) Route() string { return RouterKey }
+func (msg MsgUpdateLockLimits) Type() string  { return TypeMsgUpdateLockLimits }
+
+// Implements Msg.
+func (msg MsgUpdateLockLimits) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgUpdateLockLimits.Operator is empty")
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrLockLimits("empty MsgUpdateLockLimits.LockProxyHash")
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrLockLimits(fmt.Sprintf("Invalid denom: %s", msg.SourceAssetDenom))
+	}
+	if msg.ToChainId <= 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	if err := msg.Limits.Validate(); err != nil {
+		return ErrLockLimits(err.Error())
+	}
+	return nil
+}
+
+func (msg MsgUpdateLockLimits) String() string {
+	return fmt.Sprintf(`MsgUpdateLockLimits:
+  Operator:         %s
+  LockProxyHash:    %x
+  SourceAssetDenom: %s
+  ToChainId:        %d
+  Limits:           %s
+`, msg.Operator.String(), msg.LockProxyHash, msg.SourceAssetDenom, msg.ToChainId, msg.Limits.String())
+}
+
+// Implements Msg.
+func (msg MsgUpdateLockLimits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUpdateLockLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyBindDelayBlocks = []byte("BindDelayBlocks")
+)
+
+type Params struct {
+	BindDelayBlocks uint64 `json:"bind_delay_blocks" yaml:"bind_delay_blocks"` // blocks a proxy or asset binding waits before it takes effect, 0 binds at once
+}
+
+// ParamTable for lockproxy module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(bindDelayBlocks uint64) Params {
+	return Params{BindDelayBlocks: bindDelayBlocks}
+}
+
+// default lockproxy module parameters
+func DefaultParams() Params {
+	return Params{
+		BindDelayBlocks: 0,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateBindDelayBlocks(p.BindDelayBlocks); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateBindDelayBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Lockproxy Params:
+  Bind Delay Blocks:                %d
+`,
+		p.BindDelayBlocks,
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyBindDelayBlocks, &p.BindDelayBlocks, validateBindDelayBlocks),
+	}
+}
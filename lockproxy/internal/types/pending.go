@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// PendingProxyBinding is a binding of the lock proxy ProxyHash to ToProxyHash of ToChainId that takes effect
+// at the end of the block EffectiveHeight, unless the operator cancels it before
+type PendingProxyBinding struct {
+	ProxyHash       []byte `json:"proxy_hash" yaml:"proxy_hash"`
+	ToChainId       uint64 `json:"to_chain_id" yaml:"to_chain_id"`
+	ToProxyHash     []byte `json:"to_proxy_hash" yaml:"to_proxy_hash"`
+	EffectiveHeight int64  `json:"effective_height" yaml:"effective_height"`
+}
+
+func NewPendingProxyBinding(proxyHash []byte, toChainId uint64, toProxyHash []byte, effectiveHeight int64) PendingProxyBinding {
+	return PendingProxyBinding{ProxyHash: proxyHash, ToChainId: toChainId, ToProxyHash: toProxyHash, EffectiveHeight: effectiveHeight}
+}
+
+func (b PendingProxyBinding) String() string {
+	return fmt.Sprintf(`PendingProxyBinding:
+  ProxyHash:            %s
+  ToChainId:            %d
+  ToProxyHash:          %s
+  EffectiveHeight:      %d
+`, hex.EncodeToString(b.ProxyHash), b.ToChainId, hex.EncodeToString(b.ToProxyHash), b.EffectiveHeight)
+}
+
+// PendingAssetBinding is a binding of SourceAssetDenom to ToAssetHash of ToChainId by the lock proxy ProxyHash
+// that takes effect at the end of the block EffectiveHeight, unless the operator cancels it before
+type PendingAssetBinding struct {
+	ProxyHash        []byte        `json:"proxy_hash" yaml:"proxy_hash"`
+	SourceAssetDenom string        `json:"source_asset_denom" yaml:"source_asset_denom"`
+	ToChainId        uint64        `json:"to_chain_id" yaml:"to_chain_id"`
+	ToAssetHash      []byte        `json:"to_asset_hash" yaml:"to_asset_hash"`
+	EffectiveHeight  int64         `json:"effective_height" yaml:"effective_height"`
+	Decimals         AssetDecimals `json:"decimals" yaml:"decimals"`
+}
+
+func NewPendingAssetBinding(proxyHash []byte, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, decimals AssetDecimals, effectiveHeight int64) PendingAssetBinding {
+	return PendingAssetBinding{ProxyHash: proxyHash, SourceAssetDenom: sourceAssetDenom, ToChainId: toChainId, ToAssetHash: toAssetHash, EffectiveHeight: effectiveHeight, Decimals: decimals}
+}
+
+func (b PendingAssetBinding) String() string {
+	return fmt.Sprintf(`PendingAssetBinding:
+  ProxyHash:            %s
+  SourceAssetDenom:     %s
+  ToChainId:            %d
+  ToAssetHash:          %s
+  EffectiveHeight:      %d
+  Decimals:             %s
+`, hex.EncodeToString(b.ProxyHash), b.SourceAssetDenom, b.ToChainId, hex.EncodeToString(b.ToAssetHash), b.EffectiveHeight, b.Decimals.String())
+}
+
+// PendingBindings are the bindings of a lock proxy waiting for the bind delay to pass
+type PendingBindings struct {
+	ProxyBindings []PendingProxyBinding `json:"proxy_bindings" yaml:"proxy_bindings"`
+	AssetBindings []PendingAssetBinding `json:"asset_bindings" yaml:"asset_bindings"`
+}
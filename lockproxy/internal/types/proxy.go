@@ -0,0 +1,33 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// MaxLockProxySaltLength bounds the salt a lock proxy hash is derived from
+const MaxLockProxySaltLength = 64
+
+// GetLockProxyHash returns the hash of the lock proxy created by creator with salt, so that a creator can run as
+// many lock proxies as salts. The creator is length prefixed so that no creator and salt derive the hash of another
+func GetLockProxyHash(creator sdk.AccAddress, salt string) []byte {
+	b := append(append([]byte(ModuleName+"/lock_proxy/"), byte(len(creator))), creator...)
+	return tmhash.SumTruncated(append(b, salt...))
+}
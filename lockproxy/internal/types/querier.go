@@ -25,6 +25,16 @@ const (
 	QueryProxyByOperator = "query_proxy_by_operator"
 	QueryProxyHash       = "proxy_hash"
 	QueryAssetHash       = "asset_hash"
+	QueryLockedBalance   = "locked_balance"
+	QueryPendingBindings = "pending_bindings"
+	QueryLockProxy       = "lock_proxy"
+	QueryProxyBalance    = "proxy_balance"
+	QueryLockProxies     = "lock_proxies"
+	QueryProxyBindings   = "proxy_bindings"
+	QueryAssetBindings   = "asset_bindings"
+
+	// DefaultQueryLimit is the number of entries a page of the listing queries holds when no limit is given
+	DefaultQueryLimit = 100
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -57,3 +67,70 @@ type QueryAssetHashParam struct {
 func NewQueryAssetHashParam(lockProxyHash []byte, sourceAssetDenom string, chainId uint64) QueryAssetHashParam {
 	return QueryAssetHashParam{LockProxyHash: lockProxyHash, SourceAssetDenom: sourceAssetDenom, ChainId: chainId}
 }
+
+type QueryLockedBalanceParam struct {
+	Denom   string
+	ChainId uint64
+}
+
+func NewQueryLockedBalanceParam(denom string, chainId uint64) QueryLockedBalanceParam {
+	return QueryLockedBalanceParam{Denom: denom, ChainId: chainId}
+}
+
+type QueryPendingBindingsParam struct {
+	LockProxyHash []byte
+}
+
+func NewQueryPendingBindingsParam(lockProxyHash []byte) QueryPendingBindingsParam {
+	return QueryPendingBindingsParam{LockProxyHash: lockProxyHash}
+}
+
+type QueryLockProxyParam struct {
+	LockProxyHash []byte
+}
+
+func NewQueryLockProxyParam(lockProxyHash []byte) QueryLockProxyParam {
+	return QueryLockProxyParam{LockProxyHash: lockProxyHash}
+}
+
+type QueryProxyBalanceParam struct {
+	LockProxyHash []byte
+}
+
+func NewQueryProxyBalanceParam(lockProxyHash []byte) QueryProxyBalanceParam {
+	return QueryProxyBalanceParam{LockProxyHash: lockProxyHash}
+}
+
+// QueryLockProxiesParam lists the Page-th page of Limit lock proxies, pages start from 1
+type QueryLockProxiesParam struct {
+	Page  int
+	Limit int
+}
+
+func NewQueryLockProxiesParam(page, limit int) QueryLockProxiesParam {
+	return QueryLockProxiesParam{Page: page, Limit: limit}
+}
+
+// QueryProxyBindingsParam lists the Page-th page of Limit proxy bindings of LockProxyHash, pages start from 1
+type QueryProxyBindingsParam struct {
+	LockProxyHash []byte
+	Page          int
+	Limit         int
+}
+
+func NewQueryProxyBindingsParam(lockProxyHash []byte, page, limit int) QueryProxyBindingsParam {
+	return QueryProxyBindingsParam{LockProxyHash: lockProxyHash, Page: page, Limit: limit}
+}
+
+// QueryAssetBindingsParam lists the Page-th page of Limit asset bindings of SourceAssetDenom by LockProxyHash, or
+// of all the denoms when SourceAssetDenom is empty, pages start from 1
+type QueryAssetBindingsParam struct {
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	Page             int
+	Limit            int
+}
+
+func NewQueryAssetBindingsParam(lockProxyHash []byte, sourceAssetDenom string, page, limit int) QueryAssetBindingsParam {
+	return QueryAssetBindingsParam{LockProxyHash: lockProxyHash, SourceAssetDenom: sourceAssetDenom, Page: page, Limit: limit}
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package lockproxy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common/migrations"
+)
+
+// RegisterMigrations declares the store consensus version of the module and the migrations up to it
+func RegisterMigrations(registry *migrations.Registry, storeKey sdk.StoreKey, k Keeper) {
+	registry.RegisterModule(ModuleName, storeKey, ConsensusVersion)
+	// length prefix the proxy and asset hashes of the binding keys
+	registry.RegisterMigration(ModuleName, 1, k.MigrateBindKeys)
+	// account the escrowed coins as unassigned locked balances
+	registry.RegisterMigration(ModuleName, 2, k.MigrateLockedBalances)
+	// record the owners of the lock proxies apart from their hash
+	registry.RegisterMigration(ModuleName, 3, k.MigrateLockProxyOwners)
+	// index each lock proxy under its operator, which may operate many
+	registry.RegisterMigration(ModuleName, 4, k.MigrateOperatorIndex)
+	// escrow the coins of each lock proxy apart
+	registry.RegisterMigration(ModuleName, 5, k.MigrateProxyBalances)
+}
@@ -207,14 +207,12 @@ func (k Keeper) RegisterAsset(ctx sdk.Context, fromChainId uint64, fromContractA
 }
 
 func (k Keeper) CreateCoinAndDelegateToProxy(ctx sdk.Context, creator sdk.AccAddress, coin sdk.Coin, lockproxyHash []byte, nativeChainId uint64, nativeLockProxyHash []byte, nativeAssetHash []byte) error {
-	if len(k.ccmKeeper.GetDenomCreator(ctx, coin.Denom)) != 0 {
-		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("denom:%s already exists", coin.Denom))
-	}
 	if exist := k.EnsureLockProxyExist(ctx, lockproxyHash); !exist {
 		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("lockproxy with hash: %s not created", lockproxyHash))
 	}
-
-	k.ccmKeeper.SetDenomCreator(ctx, coin.Denom, creator)
+	if err := k.ccmKeeper.RegisterDenom(ctx, creator, coin.Denom, types.ModuleName, 0, "", nativeChainId, nativeAssetHash); err != nil {
+		return types.ErrCreateCoinAndDelegateToProxy(fmt.Sprintf("RegisterDenom Error: %s", err.Error()))
+	}
 
 	if err := k.UpdateRegistry(ctx, lockproxyHash, []byte(coin.Denom), nativeChainId, nativeLockProxyHash, nativeAssetHash); err != nil {
 		return err
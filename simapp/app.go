@@ -20,6 +20,9 @@ package simapp
 import (
 	"github.com/polynetwork/cosmos-poly-module/btcx"
 	"github.com/polynetwork/cosmos-poly-module/ccm"
+	"github.com/polynetwork/cosmos-poly-module/common/migrations"
+	"github.com/polynetwork/cosmos-poly-module/common/telemetry"
+	"github.com/polynetwork/cosmos-poly-module/datarelay"
 	"github.com/polynetwork/cosmos-poly-module/ft"
 	"github.com/polynetwork/cosmos-poly-module/headersync"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy"
@@ -89,6 +92,7 @@ var (
 		btcx.AppModuleBasic{},
 		lockproxy.AppModuleBasic{},
 		ft.AppModuleBasic{},
+		datarelay.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -132,6 +136,10 @@ type SimApp struct {
 
 	invCheckPeriod uint
 
+	// rejectDoneCrossChainTxs opts the app into rejecting, in CheckTx, the cross chain txs already processed or
+	// targeting another chain, see ccm.RejectDoneTxDecorator
+	rejectDoneCrossChainTxs bool
+
 	// keys to access the substores
 	keys  map[string]*sdk.KVStoreKey
 	tkeys map[string]*sdk.TransientStoreKey
@@ -157,17 +165,21 @@ type SimApp struct {
 	BtcxKeeper       btcx.Keeper
 	LockProxyKeeper  lockproxy.Keeper
 	FtKeeper         ft.Keeper
+	DataRelayKeeper  datarelay.Keeper
 	// the module manager
 	mm *module.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// store migrations of the bridge modules
+	migrations *migrations.Registry
 }
 
 // NewSimApp returns a reference to an initialized SimApp.
 func NewSimApp(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, skipUpgradeHeights map[int64]bool,
-	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
+	invCheckPeriod uint, rejectDoneCrossChainTxs bool, baseAppOptions ...func(*bam.BaseApp),
 ) *SimApp {
 
 	cdc := MakeCodec()
@@ -181,17 +193,18 @@ func NewSimApp(
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
 		headersync.StoreKey, ccm.StoreKey,
-		btcx.StoreKey, lockproxy.StoreKey, ft.StoreKey,
+		btcx.StoreKey, lockproxy.StoreKey, ft.StoreKey, datarelay.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
 	app := &SimApp{
-		BaseApp:        bApp,
-		cdc:            cdc,
-		invCheckPeriod: invCheckPeriod,
-		keys:           keys,
-		tkeys:          tkeys,
-		subspaces:      make(map[string]params.Subspace),
+		BaseApp:                 bApp,
+		cdc:                     cdc,
+		invCheckPeriod:          invCheckPeriod,
+		rejectDoneCrossChainTxs: rejectDoneCrossChainTxs,
+		keys:                    keys,
+		tkeys:                   tkeys,
+		subspaces:               make(map[string]params.Subspace),
 	}
 
 	// init params keeper and subspaces
@@ -206,6 +219,8 @@ func NewSimApp(
 	app.subspaces[crisis.ModuleName] = app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[evidence.ModuleName] = app.ParamsKeeper.Subspace(evidence.DefaultParamspace)
 	app.subspaces[ccm.ModuleName] = app.ParamsKeeper.Subspace(ccm.DefaultParamspace)
+	app.subspaces[datarelay.ModuleName] = app.ParamsKeeper.Subspace(datarelay.DefaultParamspace)
+	app.subspaces[lockproxy.ModuleName] = app.ParamsKeeper.Subspace(lockproxy.DefaultParamspace)
 
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -265,13 +280,16 @@ func NewSimApp(
 	app.HeaderSyncKeeper = headersync.NewKeeper(app.cdc, keys[headersync.StoreKey])
 	app.CcmKeeper = ccm.NewKeeper(app.cdc, keys[ccm.StoreKey], app.subspaces[ccm.ModuleName], app.HeaderSyncKeeper, app.SupplyKeeper)
 	app.BtcxKeeper = btcx.NewKeeper(app.cdc, keys[btcx.StoreKey], app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.CcmKeeper)
-	app.LockProxyKeeper = lockproxy.NewKeeper(app.cdc, keys[lockproxy.StoreKey], app.AccountKeeper, app.SupplyKeeper, app.CcmKeeper)
+	app.LockProxyKeeper = lockproxy.NewKeeper(app.cdc, keys[lockproxy.StoreKey], app.subspaces[lockproxy.ModuleName], app.AccountKeeper, app.SupplyKeeper, app.CcmKeeper, app.BlacklistedAccAddrs())
 	app.FtKeeper = ft.NewKeeper(app.cdc, keys[ft.StoreKey], app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.CcmKeeper)
+	app.DataRelayKeeper = datarelay.NewKeeper(app.cdc, keys[datarelay.StoreKey], app.subspaces[datarelay.ModuleName], app.CcmKeeper)
 	app.CcmKeeper.MountUnlockKeeperMap(map[string]ccm.UnlockKeeper{
 		btcx.StoreKey:      app.BtcxKeeper,
 		ft.StoreKey:        app.FtKeeper,
 		lockproxy.StoreKey: app.LockProxyKeeper,
 	})
+	app.CcmKeeper.MountDataKeeper(app.DataRelayKeeper)
+	app.CcmKeeper.MountRouter(app.Router())
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -293,24 +311,41 @@ func NewSimApp(
 		btcx.NewAppModule(app.BtcxKeeper),
 		lockproxy.NewAppModule(app.LockProxyKeeper),
 		ft.NewAppModule(app.FtKeeper),
+		datarelay.NewAppModule(app.DataRelayKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, ccm.ModuleName, lockproxy.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
-		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName,
+		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName, lockproxy.ModuleName, ccm.ModuleName,
+		datarelay.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.mm.RegisterRoutes(ccm.NewRelayFeeRefundRouter(app.CcmKeeper, app.Router()), app.QueryRouter())
+
+	// the store migrations of the bridge modules run from the upgrade handlers
+	app.migrations = migrations.NewRegistry()
+	headersync.RegisterMigrations(app.migrations, keys[headersync.StoreKey], app.HeaderSyncKeeper)
+	ccm.RegisterMigrations(app.migrations, keys[ccm.StoreKey], app.CcmKeeper)
+	btcx.RegisterMigrations(app.migrations, keys[btcx.StoreKey], app.BtcxKeeper)
+	lockproxy.RegisterMigrations(app.migrations, keys[lockproxy.StoreKey], app.LockProxyKeeper)
+	ft.RegisterMigrations(app.migrations, keys[ft.StoreKey], app.FtKeeper)
+	datarelay.RegisterMigrations(app.migrations, keys[datarelay.StoreKey], app.DataRelayKeeper)
+	if err := app.migrations.Validate(); err != nil {
+		panic(err)
+	}
+	// the stores added since, such as the datarelay one, need no store upgrade: the multistore of this sdk version
+	// loads a mounted store missing from the last commit empty, and the upgrade records its consensus version
+	app.UpgradeKeeper.SetUpgradeHandler(migrations.UpgradeName, app.migrations.UpgradeHandler())
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -330,6 +365,9 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// the bridge metrics are served along with the tendermint ones
+	telemetry.EnablePrometheus(telemetry.DefaultNamespace)
+
 	// initialize stores
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
@@ -337,7 +375,7 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(app.newAnteHandler())
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -363,10 +401,35 @@ func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Re
 	return app.mm.EndBlock(ctx, req)
 }
 
+// newAnteHandler chains the ante decorators of auth, with the relay txs marked so that their fee is refunded once
+// they succeed, within the RelayFeeWaiverLimit of ccm, see ccm.RelayFeeRefundRouter. The waiver is off as long as
+// the limit is 0
+func (app *SimApp) newAnteHandler() sdk.AnteHandler {
+	decorators := []sdk.AnteDecorator{ante.NewSetUpContextDecorator()}
+	if app.rejectDoneCrossChainTxs {
+		decorators = append(decorators, ccm.NewRejectDoneTxDecorator(app.CcmKeeper))
+	}
+	decorators = append(decorators,
+		ccm.NewRelayFeeWaiverDecorator(app.CcmKeeper),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewValidateMemoDecorator(app.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(app.AccountKeeper),
+		ante.NewSetPubKeyDecorator(app.AccountKeeper),
+		ante.NewValidateSigCountDecorator(app.AccountKeeper),
+		ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper),
+		ante.NewSigGasConsumeDecorator(app.AccountKeeper, auth.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(app.AccountKeeper),
+		ante.NewIncrementSequenceDecorator(app.AccountKeeper),
+	)
+	return sdk.ChainAnteDecorators(decorators...)
+}
+
 // InitChainer application update at chain initialization
 func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+	app.migrations.InitVersions(ctx)
 	return app.mm.InitGenesis(ctx, genesisState)
 }
 
@@ -26,13 +26,22 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/polynetwork/cosmos-poly-module/btcx"
+	"github.com/polynetwork/cosmos-poly-module/ccm"
+	"github.com/polynetwork/cosmos-poly-module/common/migrations"
+	"github.com/polynetwork/cosmos-poly-module/datarelay"
+	"github.com/polynetwork/cosmos-poly-module/ft"
+	"github.com/polynetwork/cosmos-poly-module/headersync"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestSimAppExport(t *testing.T) {
 	db := dbm.NewMemDB()
-	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, false)
 
 	genesisState := NewDefaultGenesisState()
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
@@ -48,15 +57,61 @@ func TestSimAppExport(t *testing.T) {
 	app.Commit()
 
 	// Making a new app object with the db, so that initchain hasn't been called
-	app2 := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app2 := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, false)
 	_, _, err = app2.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestAppGenesisImportExport(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, 0, false)
+
+	genesisState := NewDefaultGenesisState()
+	ccmGenesis := ccm.DefaultGenesisState()
+	ccmGenesis.Params.ChainIdInPolyNet = SimChainIdInPolyNet
+	ccmGenesis.Params.ChainRegistry = []ccm.ChainInfo{ccm.NewChainInfo(2, "ethereum", ccm.VMFamilyEVM, 20, ccm.AddressEncodingRaw, 32, true)}
+	ccmGenesis.Params.AllowedCrossChainCalls = []ccm.AllowedCrossChainCall{ccm.NewAllowedCrossChainCall(2, []byte{1, 2}, "deposit")}
+	ccmGenesis.Params.AllowedRemoteMsgTypes = []string{"bank/send"}
+	genesisState[ccm.ModuleName] = app.Codec().MustMarshalJSON(ccmGenesis)
+	datarelayGenesis := datarelay.NewGenesisState(
+		datarelay.NewParams([]datarelay.Sender{datarelay.NewSender(2, []byte{1, 2})}),
+		[]datarelay.StoredData{{FromChainId: 2, FromContract: []byte{1, 2}, Topic: "price", Data: []byte{3}, PolyHeight: 7}},
+	)
+	genesisState[datarelay.ModuleName] = app.Codec().MustMarshalJSON(datarelayGenesis)
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	exportGenesis := func(app *SimApp) GenesisState {
+		appState, _, err := app.ExportAppStateAndValidators(false, []string{})
+		require.NoError(t, err)
+		var exported GenesisState
+		require.NoError(t, app.Codec().UnmarshalJSON(appState, &exported))
+		return exported
+	}
+	exported := exportGenesis(NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, 0, false))
+	for _, name := range []string{ccm.ModuleName, datarelay.ModuleName} {
+		require.JSONEq(t, string(genesisState[name]), string(exported[name]), name)
+	}
+
+	// the exported genesis starts a chain exporting it again
+	db = dbm.NewMemDB()
+	app = NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, 0, false)
+	stateBytes, err = codec.MarshalJSONIndent(app.Codec(), exported)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+	reexported := exportGenesis(NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, 0, false))
+	for _, name := range []string{ccm.ModuleName, datarelay.ModuleName} {
+		require.JSONEq(t, string(exported[name]), string(reexported[name]), name)
+	}
+}
+
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
-	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, false)
 
 	for acc := range maccPerms {
 		require.Equal(t, !allowedReceivingModAcc[acc], app.BankKeeper.BlacklistedAddr(app.SupplyKeeper.GetModuleAddress(acc)))
@@ -67,3 +122,27 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestStoreMigrations(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// a chain started from genesis is at the consensus versions
+	for _, name := range []string{headersync.ModuleName, ccm.ModuleName, btcx.ModuleName, lockproxy.ModuleName, ft.ModuleName, datarelay.ModuleName} {
+		require.Equal(t, app.migrations.ConsensusVersion(name), app.migrations.GetStoredVersion(ctx, name), name)
+	}
+
+	// a chain running the unversioned stores catches up at the upgrade
+	ctx.KVStore(app.GetKey(ccm.StoreKey)).Delete(migrations.VersionKey)
+	ctx.KVStore(app.GetKey(lockproxy.StoreKey)).Delete(migrations.VersionKey)
+	// the datarelay store is added by the upgrade
+	ctx.KVStore(app.GetKey(datarelay.StoreKey)).Delete(migrations.VersionKey)
+	ctx.KVStore(app.GetKey(params.StoreKey)).Delete(append([]byte(ccm.DefaultParamspace+"/"), ccm.KeyChainRegistry...))
+	require.Equal(t, uint64(1), app.migrations.GetStoredVersion(ctx, ccm.ModuleName))
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: migrations.UpgradeName})
+	require.NotPanics(t, func() { app.CcmKeeper.GetParams(ctx) })
+	require.Equal(t, uint64(ccm.ConsensusVersion), app.migrations.GetStoredVersion(ctx, ccm.ModuleName))
+	require.Equal(t, uint64(lockproxy.ConsensusVersion), app.migrations.GetStoredVersion(ctx, lockproxy.ModuleName))
+	require.Equal(t, uint64(datarelay.ConsensusVersion), app.migrations.GetStoredVersion(ctx, datarelay.ModuleName))
+	require.Empty(t, app.DataRelayKeeper.GetRegisteredSenders(ctx))
+}
@@ -19,8 +19,14 @@ package simapp
 
 import (
 	"encoding/json"
+
+	"github.com/polynetwork/cosmos-poly-module/ccm"
 )
 
+// SimChainIdInPolyNet is the chain id of the simulated chain in the poly chain network, the ccm genesis has no
+// usable default for it
+const SimChainIdInPolyNet uint64 = 5
+
 // The genesis state of the blockchain is represented here as a map of raw json
 // messages key'd by a identifier string.
 // The identifier is used to determine which module genesis information belongs
@@ -32,5 +38,9 @@ type GenesisState map[string]json.RawMessage
 
 // NewDefaultGenesisState generates the default state for the application.
 func NewDefaultGenesisState() GenesisState {
-	return ModuleBasics.DefaultGenesis()
+	genesisState := ModuleBasics.DefaultGenesis()
+	ccmGenesis := ccm.DefaultGenesisState()
+	ccmGenesis.Params.ChainIdInPolyNet = SimChainIdInPolyNet
+	genesisState[ccm.ModuleName] = ccm.ModuleCdc.MustMarshalJSON(ccmGenesis)
+	return genesisState
 }
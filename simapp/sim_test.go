@@ -42,6 +42,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy"
 )
 
 // Get flags every time the simulator is run
@@ -79,7 +80,7 @@ func TestFullAppSimulation(t *testing.T) {
 		require.NoError(t, os.RemoveAll(dir))
 	}()
 
-	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, fauxMerkleModeOpt)
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, false, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
 	// run randomized simulation
@@ -111,7 +112,7 @@ func TestAppImportExport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(dir))
 	}()
 
-	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, fauxMerkleModeOpt)
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, false, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
@@ -145,7 +146,7 @@ func TestAppImportExport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(newDir))
 	}()
 
-	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, FlagPeriodValue, fauxMerkleModeOpt)
+	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, FlagPeriodValue, false, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", newApp.Name())
 
 	var genesisState GenesisState
@@ -171,6 +172,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
 		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
 		{app.keys[gov.StoreKey], newApp.keys[gov.StoreKey], [][]byte{}},
+		{app.keys[lockproxy.StoreKey], newApp.keys[lockproxy.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -197,7 +199,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(dir))
 	}()
 
-	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, fauxMerkleModeOpt)
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, false, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
@@ -236,7 +238,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(newDir))
 	}()
 
-	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, FlagPeriodValue, fauxMerkleModeOpt)
+	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, FlagPeriodValue, false, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", newApp.Name())
 
 	newApp.InitChain(abci.RequestInitChain{
@@ -282,7 +284,7 @@ func TestAppStateDeterminism(t *testing.T) {
 
 			db := dbm.NewMemDB()
 
-			app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, interBlockCacheOpt())
+			app := NewSimApp(logger, db, nil, true, map[int64]bool{}, FlagPeriodValue, false, interBlockCacheOpt())
 
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
@@ -312,3 +314,170 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
+
+func TestLockProxyGenesisImportExport(t *testing.T) {
+	app := Setup(false)
+	ctx := app.NewContext(false, abci.Header{})
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	k := app.LockProxyKeeper
+
+	operator := sdk.AccAddress([]byte("lockproxy-operator20"))
+	require.NoError(t, k.CreateLockProxy(ctx, operator, ""))
+	require.NoError(t, k.CreateLockProxy(ctx, operator, "idle"))
+	proxy := lockproxy.GetLockProxyHash(operator, "")
+	require.NoError(t, k.CreateCoinAndDelegateToProxy(ctx, operator, sdk.NewCoin("lpcoin", sdk.NewInt(1000)), proxy))
+	require.NoError(t, k.BindProxyHash(ctx, operator, proxy, 2, []byte{0x02, 0x02}))
+	require.NoError(t, k.BindProxyHash(ctx, operator, proxy, 3, []byte{0x03, 0x03}))
+	require.NoError(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte{0x12}, lockproxy.AssetDecimals{}))
+	require.NoError(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 3, []byte{0x13}, lockproxy.AssetDecimals{}))
+	limits := lockproxy.NewLockLimits(sdk.NewInt(10), sdk.NewInt(500))
+	require.NoError(t, k.UpdateLockLimits(ctx, operator, proxy, "lpcoin", 3, limits))
+	k.SetParams(ctx, lockproxy.NewParams(5))
+	require.NoError(t, k.BindAssetHash(ctx, operator, proxy, "lpcoin", 2, []byte{0x22}, lockproxy.AssetDecimals{}))
+
+	exported := lockproxy.ExportGenesis(ctx, k)
+	require.NoError(t, lockproxy.ValidateGenesis(exported))
+	require.Equal(t, lockproxy.NewParams(5), exported.Params)
+	require.Empty(t, exported.PendingBindings.ProxyBindings)
+	require.Equal(t, []lockproxy.PendingAssetBinding{lockproxy.NewPendingAssetBinding(proxy, "lpcoin", 2, []byte{0x22}, lockproxy.AssetDecimals{}, 5)}, exported.PendingBindings.AssetBindings)
+	require.Len(t, exported.LockProxies, 2)
+	require.Equal(t, k.GetLockProxiesByOperator(ctx, operator), exported.LockProxies)
+	require.Equal(t, []lockproxy.ProxyBinding{
+		{ProxyHash: proxy, ToChainId: 2, ToProxyHash: []byte{0x02, 0x02}},
+		{ProxyHash: proxy, ToChainId: 3, ToProxyHash: []byte{0x03, 0x03}},
+	}, exported.ProxyBindings)
+	require.Equal(t, []lockproxy.AssetBinding{
+		{ProxyHash: proxy, SourceAssetDenom: "lpcoin", ToChainId: 2, ToAssetHash: []byte{0x12}, Limits: lockproxy.NewLockLimits(sdk.ZeroInt(), sdk.ZeroInt())},
+		{ProxyHash: proxy, SourceAssetDenom: "lpcoin", ToChainId: 3, ToAssetHash: []byte{0x13}, Limits: limits},
+	}, exported.AssetBindings)
+	require.Equal(t, []lockproxy.LockedBalance{lockproxy.NewLockedBalance("lpcoin", lockproxy.UnassignedChainId, sdk.NewInt(1000))}, exported.LockedBalances)
+	require.Equal(t, []lockproxy.ProxyBalance{lockproxy.NewProxyBalance(proxy, "lpcoin", sdk.NewInt(1000))}, exported.ProxyBalances)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(1000))), exported.ModuleBalance)
+
+	var imported lockproxy.GenesisState
+	app.Codec().MustUnmarshalJSON(app.Codec().MustMarshalJSON(exported), &imported)
+
+	newApp := Setup(false)
+	newCtx := newApp.NewContext(false, abci.Header{})
+
+	// the module account has to hold the exported balance
+	require.Panics(t, func() { lockproxy.InitGenesis(newCtx, newApp.LockProxyKeeper, imported) })
+	moduleAddr := newApp.SupplyKeeper.GetModuleAddress(lockproxy.ModuleName)
+	require.NoError(t, newApp.BankKeeper.SetCoins(newCtx, moduleAddr, imported.ModuleBalance))
+	lockproxy.InitGenesis(newCtx, newApp.LockProxyKeeper, imported)
+
+	failedKVAs, failedKVBs := sdk.DiffKVStores(ctx.KVStore(app.keys[lockproxy.StoreKey]), newCtx.KVStore(newApp.keys[lockproxy.StoreKey]), nil)
+	require.Empty(t, failedKVAs)
+	require.Empty(t, failedKVBs)
+	require.Equal(t, exported, lockproxy.ExportGenesis(newCtx, newApp.LockProxyKeeper))
+	require.True(t, newApp.LockProxyKeeper.EnsureLockProxyExist(newCtx, lockproxy.GetLockProxyHash(operator, "idle")))
+	require.Equal(t, []byte{0x13}, newApp.LockProxyKeeper.GetAssetHash(newCtx, proxy, "lpcoin", 3))
+	require.Equal(t, limits, newApp.LockProxyKeeper.GetLockLimits(newCtx, proxy, "lpcoin", 3))
+}
+
+func TestLockProxyValidateGenesis(t *testing.T) {
+	operator := sdk.AccAddress([]byte("lockproxy-operator20"))
+	valid := func() lockproxy.GenesisState {
+		return lockproxy.NewGenesisState(
+			lockproxy.NewParams(10),
+			[]lockproxy.LockProxy{{Operator: operator, ProxyHash: operator.Bytes()}},
+			[]lockproxy.ProxyBinding{{ProxyHash: operator.Bytes(), ToChainId: 2, ToProxyHash: []byte{0x02}}},
+			[]lockproxy.AssetBinding{{ProxyHash: operator.Bytes(), SourceAssetDenom: "lpcoin", ToChainId: 2, ToAssetHash: []byte{0x12}}},
+			lockproxy.PendingBindings{
+				ProxyBindings: []lockproxy.PendingProxyBinding{lockproxy.NewPendingProxyBinding(operator.Bytes(), 2, []byte{0x22}, 10)},
+				AssetBindings: []lockproxy.PendingAssetBinding{lockproxy.NewPendingAssetBinding(operator.Bytes(), "lpcoin", 3, []byte{0x13}, lockproxy.AssetDecimals{}, 10)},
+			},
+			[]lockproxy.LockedBalance{
+				lockproxy.NewLockedBalance("lpcoin", lockproxy.UnassignedChainId, sdk.NewInt(600)),
+				lockproxy.NewLockedBalance("lpcoin", 2, sdk.NewInt(400)),
+			},
+			[]lockproxy.ProxyBalance{lockproxy.NewProxyBalance(operator.Bytes(), "lpcoin", sdk.NewInt(1000))},
+			sdk.NewCoins(sdk.NewCoin("lpcoin", sdk.NewInt(1000))),
+			[]lockproxy.UnassignedClaimant{},
+		)
+	}
+	require.NoError(t, lockproxy.ValidateGenesis(lockproxy.DefaultGenesisState()))
+	require.NoError(t, lockproxy.ValidateGenesis(valid()))
+
+	// a proxy keeps its hash once transferred to another owner
+	transferred := valid()
+	transferred.LockProxies[0].Operator = sdk.AccAddress([]byte("lockproxy-newowner20"))
+	transferred.LockProxies[0].PendingOwner = operator
+	require.NoError(t, lockproxy.ValidateGenesis(transferred))
+
+	// an operator may own many proxies
+	salted := valid()
+	salted.LockProxies = append(salted.LockProxies, lockproxy.LockProxy{Operator: operator, ProxyHash: lockproxy.GetLockProxyHash(operator, "salt")})
+	require.NoError(t, lockproxy.ValidateGenesis(salted))
+
+	// the escrow of no known lock proxy is accounted under the unassigned proxy hash
+	unassigned := valid()
+	unassigned.ProxyBalances = []lockproxy.ProxyBalance{
+		lockproxy.NewProxyBalance(lockproxy.UnassignedProxyHash, "lpcoin", sdk.NewInt(400)),
+		lockproxy.NewProxyBalance(operator.Bytes(), "lpcoin", sdk.NewInt(600)),
+	}
+	require.NoError(t, lockproxy.ValidateGenesis(unassigned))
+	unassigned.UnassignedClaimants = []lockproxy.UnassignedClaimant{lockproxy.NewUnassignedClaimant(operator.Bytes(), "lpcoin")}
+	require.NoError(t, lockproxy.ValidateGenesis(unassigned))
+
+	testCases := []struct {
+		name     string
+		malleate func(gs *lockproxy.GenesisState)
+	}{
+		{"proxy with empty hash", func(gs *lockproxy.GenesisState) { gs.LockProxies[0].ProxyHash = nil }},
+		{"pending owner is the operator", func(gs *lockproxy.GenesisState) { gs.LockProxies[0].PendingOwner = operator }},
+		{"duplicated proxy", func(gs *lockproxy.GenesisState) { gs.LockProxies = append(gs.LockProxies, gs.LockProxies[0]) }},
+		{"proxy binding of unknown proxy", func(gs *lockproxy.GenesisState) { gs.ProxyBindings[0].ProxyHash = []byte{0x01} }},
+		{"proxy binding to chain zero", func(gs *lockproxy.GenesisState) { gs.ProxyBindings[0].ToChainId = 0 }},
+		{"duplicated proxy binding", func(gs *lockproxy.GenesisState) { gs.ProxyBindings = append(gs.ProxyBindings, gs.ProxyBindings[0]) }},
+		{"asset binding of unknown proxy", func(gs *lockproxy.GenesisState) { gs.AssetBindings[0].ProxyHash = []byte{0x01} }},
+		{"asset binding of invalid denom", func(gs *lockproxy.GenesisState) { gs.AssetBindings[0].SourceAssetDenom = "1" }},
+		{"asset binding to empty hash", func(gs *lockproxy.GenesisState) { gs.AssetBindings[0].ToAssetHash = nil }},
+		{"duplicated asset binding", func(gs *lockproxy.GenesisState) { gs.AssetBindings = append(gs.AssetBindings, gs.AssetBindings[0]) }},
+		{"asset binding with inverted lock limits", func(gs *lockproxy.GenesisState) {
+			gs.AssetBindings[0].Limits = lockproxy.NewLockLimits(sdk.NewInt(10), sdk.NewInt(5))
+		}},
+		{"asset binding with invalid decimals", func(gs *lockproxy.GenesisState) {
+			gs.AssetBindings[0].Decimals.ToDecimals = lockproxy.MaxAssetDecimals + 1
+		}},
+		{"invalid module balance", func(gs *lockproxy.GenesisState) {
+			gs.ModuleBalance = sdk.Coins{sdk.Coin{Denom: "lpcoin", Amount: sdk.NewInt(-1)}}
+		}},
+		{"pending proxy binding of unknown proxy", func(gs *lockproxy.GenesisState) { gs.PendingBindings.ProxyBindings[0].ProxyHash = []byte{0x01} }},
+		{"pending proxy binding at height zero", func(gs *lockproxy.GenesisState) { gs.PendingBindings.ProxyBindings[0].EffectiveHeight = 0 }},
+		{"pending asset binding to empty hash", func(gs *lockproxy.GenesisState) { gs.PendingBindings.AssetBindings[0].ToAssetHash = nil }},
+		{"pending asset binding with invalid decimals", func(gs *lockproxy.GenesisState) {
+			gs.PendingBindings.AssetBindings[0].Decimals.SourceDecimals = lockproxy.MaxAssetDecimals + 1
+		}},
+		{"duplicated pending asset binding", func(gs *lockproxy.GenesisState) {
+			gs.PendingBindings.AssetBindings = append(gs.PendingBindings.AssetBindings, gs.PendingBindings.AssetBindings[0])
+		}},
+		{"zero locked balance", func(gs *lockproxy.GenesisState) { gs.LockedBalances[1].Amount = sdk.ZeroInt() }},
+		{"duplicated locked balance", func(gs *lockproxy.GenesisState) { gs.LockedBalances[1].ChainId = lockproxy.UnassignedChainId }},
+		{"locked balances not adding up", func(gs *lockproxy.GenesisState) { gs.LockedBalances = gs.LockedBalances[:1] }},
+		{"proxy balance of unknown proxy", func(gs *lockproxy.GenesisState) { gs.ProxyBalances[0].ProxyHash = []byte{0x01} }},
+		{"proxy balances not adding up", func(gs *lockproxy.GenesisState) { gs.ProxyBalances[0].Amount = sdk.NewInt(999) }},
+		{"unassigned claimant of unknown proxy", func(gs *lockproxy.GenesisState) {
+			gs.ProxyBalances = unassigned.ProxyBalances
+			gs.UnassignedClaimants = []lockproxy.UnassignedClaimant{lockproxy.NewUnassignedClaimant([]byte{0x01}, "lpcoin")}
+		}},
+		{"unassigned claimant of no unassigned balance", func(gs *lockproxy.GenesisState) {
+			gs.UnassignedClaimants = []lockproxy.UnassignedClaimant{lockproxy.NewUnassignedClaimant(operator.Bytes(), "lpcoin")}
+		}},
+		{"duplicated unassigned claimant", func(gs *lockproxy.GenesisState) {
+			gs.ProxyBalances = unassigned.ProxyBalances
+			gs.UnassignedClaimants = append(unassigned.UnassignedClaimants, unassigned.UnassignedClaimants[0])
+		}},
+		{"module balance of another denom", func(gs *lockproxy.GenesisState) {
+			gs.ModuleBalance = sdk.NewCoins(sdk.NewCoin("othercoin", sdk.NewInt(1000)))
+		}},
+		{"module balance of one more denom", func(gs *lockproxy.GenesisState) {
+			gs.ModuleBalance = gs.ModuleBalance.Add(sdk.NewCoin("othercoin", sdk.NewInt(1)))
+		}},
+	}
+	for _, tc := range testCases {
+		gs := valid()
+		tc.malleate(&gs)
+		require.Error(t, lockproxy.ValidateGenesis(gs), tc.name)
+	}
+}